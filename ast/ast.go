@@ -2,7 +2,9 @@ package ast
 
 import (
 	"strings"
+	"unicode/utf8"
 
+	"github.com/uji/solparser/ast/yul"
 	"github.com/uji/solparser/token"
 )
 
@@ -139,21 +141,168 @@ type PragmaDirective struct {
 func (p PragmaDirective) Pos() token.Pos { return p.Pragma }
 func (p PragmaDirective) End() token.Pos { return p.Semicolon }
 
+// All modifier-list-element node types implement the ModifierListElement interface.
+type ModifierListElement interface {
+	Node
+	modifierListElementNode()
+}
+
+// ModifierList holds the specifiers of a function header in the order of the source.
+// (e.g. public view virtual override onlyOwner)
 type ModifierList struct {
-	Visibility      *Visibility
-	StateMutability *StateMutability
+	Elements []ModifierListElement
+}
+
+// Visibility returns the visibility of the list, or nil if it is not specified.
+func (m ModifierList) Visibility() *Visibility {
+	for _, e := range m.Elements {
+		if v, ok := e.(*Visibility); ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// StateMutability returns the state mutability of the list, or nil if it is not specified.
+func (m ModifierList) StateMutability() *StateMutability {
+	for _, e := range m.Elements {
+		if s, ok := e.(*StateMutability); ok {
+			return s
+		}
+	}
+	return nil
+}
+
+// Virtual returns the virtual keyword of the list, or nil if it is not specified.
+func (m ModifierList) Virtual() *Virtual {
+	for _, e := range m.Elements {
+		if v, ok := e.(*Virtual); ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// OverrideSpecifier returns the override specifier of the list, or nil if it is not specified.
+func (m ModifierList) OverrideSpecifier() *OverrideSpecifier {
+	for _, e := range m.Elements {
+		if o, ok := e.(*OverrideSpecifier); ok {
+			return o
+		}
+	}
+	return nil
 }
 
-type Visibility = token.Token
+// ModifierInvocations returns the modifier invocations of the list in the order of the source.
+func (m ModifierList) ModifierInvocations() []*ModifierInvocation {
+	var mis []*ModifierInvocation
+	for _, e := range m.Elements {
+		if mi, ok := e.(*ModifierInvocation); ok {
+			mis = append(mis, mi)
+		}
+	}
+	return mis
+}
+
+// internal | external | private | public
+type Visibility token.Token
+
+func (v Visibility) Pos() token.Pos { return v.Position }
+func (v Visibility) End() token.Pos {
+	return token.Pos{
+		Column: v.Position.Column + len(v.Value),
+		Line:   v.Position.Line,
+	}
+}
 
 // Parameter is type of ParameterList elements
+// TypeName DataLocation Identifier (e.g. string memory name, uint)
 type Parameter struct {
-	TypeName TypeName
+	TypeName     TypeName
+	DataLocation *token.Token // memory | storage | calldata
+	Identifier   *Identifier
+	Comma        *token.Pos
+}
+
+func (p Parameter) Pos() token.Pos { return p.TypeName.Pos() }
+func (p Parameter) End() token.Pos {
+	if p.Comma != nil {
+		return *p.Comma
+	}
+	if p.Identifier != nil {
+		return p.Identifier.End()
+	}
+	if p.DataLocation != nil {
+		return token.Pos{
+			Column: p.DataLocation.Position.Column + len(p.DataLocation.Value),
+			Line:   p.DataLocation.Position.Line,
+		}
+	}
+	return p.TypeName.End()
 }
 
 type ParameterList []*Parameter
 
-type StateMutability = token.Token
+// pure | view | payable
+type StateMutability token.Token
+
+func (s StateMutability) Pos() token.Pos { return s.Position }
+func (s StateMutability) End() token.Pos {
+	return token.Pos{
+		Column: s.Position.Column + len(s.Value),
+		Line:   s.Position.Line,
+	}
+}
+
+type Virtual struct {
+	Virtual token.Pos
+}
+
+func (v Virtual) Pos() token.Pos { return v.Virtual }
+func (v Virtual) End() token.Pos {
+	return token.Pos{Column: v.Virtual.Column + len("virtual"), Line: v.Virtual.Line}
+}
+
+type OverrideSpecifierPath struct {
+	IdentifierPath IdentifierPath
+	Comma          *token.Pos
+}
+
+// override ( IdentifierPaths ) (e.g. override, override(A, B))
+type OverrideSpecifier struct {
+	Override token.Pos
+	LParen   *token.Pos
+	Paths    []*OverrideSpecifierPath
+	RParen   *token.Pos
+}
+
+func (o OverrideSpecifier) Pos() token.Pos { return o.Override }
+func (o OverrideSpecifier) End() token.Pos {
+	if o.RParen != nil {
+		return *o.RParen
+	}
+	return token.Pos{Column: o.Override.Column + len("override"), Line: o.Override.Line}
+}
+
+// IdentifierPath CallArgumentList (e.g. onlyOwner, whenNotPaused(x))
+type ModifierInvocation struct {
+	IdentifierPath   IdentifierPath
+	CallArgumentList *CallArgumentList
+}
+
+func (m ModifierInvocation) Pos() token.Pos { return m.IdentifierPath.Pos() }
+func (m ModifierInvocation) End() token.Pos {
+	if m.CallArgumentList != nil {
+		return m.CallArgumentList.End()
+	}
+	return m.IdentifierPath.End()
+}
+
+func (v *Visibility) modifierListElementNode()         {}
+func (s *StateMutability) modifierListElementNode()    {}
+func (v *Virtual) modifierListElementNode()            {}
+func (o *OverrideSpecifier) modifierListElementNode()  {}
+func (m *ModifierInvocation) modifierListElementNode() {}
 
 // identifier | fallback | recevie
 type FunctionDescriptor = token.Token
@@ -168,26 +317,307 @@ type FunctionDefinitionReturns struct {
 // ----------------------------------------------------------------------------
 // ContractBodyElement Nodes
 
+// IdentifierPath as Operator (e.g. add as +, sub)
+type UsingAlias struct {
+	IdentifierPath IdentifierPath
+	As             *token.Pos
+	Operator       *token.Token // user-definable operator
+}
+
+func (u UsingAlias) Pos() token.Pos { return u.IdentifierPath.Pos() }
+func (u UsingAlias) End() token.Pos {
+	if u.Operator != nil {
+		return token.Pos{
+			Column: u.Operator.Position.Column + len(u.Operator.Value),
+			Line:   u.Operator.Position.Line,
+		}
+	}
+	return u.IdentifierPath.End()
+}
+
+// { UsingAlias, UsingAlias } (e.g. {add as +, sub})
+type UsingAliases struct {
+	LBrace  token.Pos
+	Aliases []*UsingAlias
+	Commas  []*token.Pos
+	RBrace  token.Pos
+}
+
+func (u UsingAliases) Pos() token.Pos { return u.LBrace }
+func (u UsingAliases) End() token.Pos { return u.RBrace }
+
+// using Library for TypeName global ; (e.g. using SafeMath for uint256;, using {add as +} for Fixed global;)
+type UsingDirective struct {
+	Using          token.Pos
+	IdentifierPath *IdentifierPath // nil if UsingAliases is specified
+	UsingAliases   *UsingAliases   // nil if IdentifierPath is specified
+	For            token.Pos
+	Mul            *token.Pos // not nil if the directive is for all types
+	TypeName       TypeName   // nil if the directive is for all types
+	Global         *token.Pos
+	Semicolon      token.Pos
+}
+
+func (u UsingDirective) Pos() token.Pos { return u.Using }
+func (u UsingDirective) End() token.Pos { return u.Semicolon }
+
 type FunctionDefinition struct {
 	From               token.Pos
 	FunctionDescriptor FunctionDescriptor
 	LParen             token.Pos
+	Parameters         ParameterList
 	RParen             token.Pos
 	ModifierList       *ModifierList
 	Returns            *FunctionDefinitionReturns
-	Block              *Block
+	Block              *Block     // nil if the function has no body
+	Semicolon          *token.Pos // not nil if the function has no body
 }
 
 func (f FunctionDefinition) Pos() token.Pos { return f.From }
-func (f FunctionDefinition) End() token.Pos { return f.Block.End() }
+func (f FunctionDefinition) End() token.Pos {
+	if f.Semicolon != nil {
+		return *f.Semicolon
+	}
+	return f.Block.End()
+}
+
+// constructor ( Parameters ) ModifierList Block (e.g. constructor(uint a) payable Base(a) {})
+type ConstructorDefinition struct {
+	Constructor  token.Pos
+	LParen       token.Pos
+	Parameters   ParameterList
+	RParen       token.Pos
+	ModifierList *ModifierList
+	Block        *Block
+}
+
+func (c ConstructorDefinition) Pos() token.Pos { return c.Constructor }
+func (c ConstructorDefinition) End() token.Pos { return c.Block.End() }
+
+// fallback ( Parameters ) ModifierList Returns Block (e.g. fallback(bytes calldata input) external returns (bytes memory))
+type FallbackFunctionDefinition struct {
+	Fallback     token.Pos
+	LParen       token.Pos
+	Parameters   ParameterList
+	RParen       token.Pos
+	ModifierList *ModifierList
+	Returns      *FunctionDefinitionReturns
+	Block        *Block     // nil if the function has no body
+	Semicolon    *token.Pos // not nil if the function has no body
+}
+
+func (f FallbackFunctionDefinition) Pos() token.Pos { return f.Fallback }
+func (f FallbackFunctionDefinition) End() token.Pos {
+	if f.Semicolon != nil {
+		return *f.Semicolon
+	}
+	return f.Block.End()
+}
+
+// receive ( ) ModifierList Block (e.g. receive() external payable {})
+type ReceiveFunctionDefinition struct {
+	Receive      token.Pos
+	LParen       token.Pos
+	RParen       token.Pos
+	ModifierList *ModifierList
+	Block        *Block     // nil if the function has no body
+	Semicolon    *token.Pos // not nil if the function has no body
+}
+
+func (r ReceiveFunctionDefinition) Pos() token.Pos { return r.Receive }
+func (r ReceiveFunctionDefinition) End() token.Pos {
+	if r.Semicolon != nil {
+		return *r.Semicolon
+	}
+	return r.Block.End()
+}
 
-func (f *FunctionDefinition) contractBodyElementNode() {}
+// modifier Identifier ( Parameters ) ModifierList Block (e.g. modifier onlyOwner() virtual { _; })
+// LParen and RParen are nil if the parameter list is omitted.
+type ModifierDefinition struct {
+	Modifier     token.Pos
+	Identifier   Identifier
+	LParen       *token.Pos
+	Parameters   ParameterList
+	RParen       *token.Pos
+	ModifierList *ModifierList // only virtual and override
+	Block        *Block        // nil if the modifier has no body
+	Semicolon    *token.Pos    // not nil if the modifier has no body
+}
+
+func (m ModifierDefinition) Pos() token.Pos { return m.Modifier }
+func (m ModifierDefinition) End() token.Pos {
+	if m.Semicolon != nil {
+		return *m.Semicolon
+	}
+	return m.Block.End()
+}
+
+// TypeName Specifiers Identifier = InitialValue ; (e.g. uint256 public constant MAX = 1e18;)
+type StateVariableDeclaration struct {
+	TypeName          TypeName
+	Visibility        *Visibility
+	Constant          *token.Pos
+	Immutable         *token.Pos
+	OverrideSpecifier *OverrideSpecifier
+	Identifier        Identifier
+	Assign            *token.Pos
+	InitialValue      Expression // nil if omitted
+	Semicolon         token.Pos
+}
+
+func (s StateVariableDeclaration) Pos() token.Pos { return s.TypeName.Pos() }
+func (s StateVariableDeclaration) End() token.Pos { return s.Semicolon }
+
+// TypeName Identifier ; in a struct definition.
+type StructMember struct {
+	TypeName   TypeName
+	Identifier Identifier
+	Semicolon  token.Pos
+}
+
+func (s StructMember) Pos() token.Pos { return s.TypeName.Pos() }
+func (s StructMember) End() token.Pos { return s.Semicolon }
+
+// struct Identifier { Members } (e.g. struct Point { uint x; uint y; })
+type StructDefinition struct {
+	Struct     token.Pos
+	Identifier Identifier
+	LBrace     token.Pos
+	Members    []*StructMember
+	RBrace     token.Pos
+}
+
+func (s StructDefinition) Pos() token.Pos { return s.Struct }
+func (s StructDefinition) End() token.Pos { return s.RBrace }
+
+type EnumValue struct {
+	Identifier Identifier
+	Comma      *token.Pos
+}
+
+// enum Identifier { Values } (e.g. enum Status { Active, Paused })
+type EnumDefinition struct {
+	Enum       token.Pos
+	Identifier Identifier
+	LBrace     token.Pos
+	Values     []*EnumValue
+	RBrace     token.Pos
+}
+
+func (e EnumDefinition) Pos() token.Pos { return e.Enum }
+func (e EnumDefinition) End() token.Pos { return e.RBrace }
+
+// type Identifier is ElementaryTypeName ; (e.g. type Price is uint128;)
+type UserDefinedValueTypeDefinition struct {
+	Type       token.Pos
+	Identifier Identifier
+	Is         token.Pos
+	TypeName   TypeName
+	Semicolon  token.Pos
+}
+
+func (u UserDefinedValueTypeDefinition) Pos() token.Pos { return u.Type }
+func (u UserDefinedValueTypeDefinition) End() token.Pos { return u.Semicolon }
+
+// TypeName indexed Identifier (e.g. address indexed from)
+type EventParameter struct {
+	TypeName   TypeName
+	Indexed    *token.Pos
+	Identifier *Identifier
+	Comma      *token.Pos
+}
+
+func (e EventParameter) Pos() token.Pos { return e.TypeName.Pos() }
+func (e EventParameter) End() token.Pos {
+	if e.Comma != nil {
+		return *e.Comma
+	}
+	if e.Identifier != nil {
+		return e.Identifier.End()
+	}
+	if e.Indexed != nil {
+		return token.Pos{Column: e.Indexed.Column + len("indexed"), Line: e.Indexed.Line}
+	}
+	return e.TypeName.End()
+}
+
+// event Identifier ( Parameters ) anonymous ; (e.g. event Transfer(address indexed from, address indexed to, uint256 value);)
+type EventDefinition struct {
+	Event      token.Pos
+	Identifier Identifier
+	LParen     token.Pos
+	Parameters []*EventParameter
+	RParen     token.Pos
+	Anonymous  *token.Pos
+	Semicolon  token.Pos
+}
+
+func (e EventDefinition) Pos() token.Pos { return e.Event }
+func (e EventDefinition) End() token.Pos { return e.Semicolon }
+
+// TypeName Identifier (e.g. address caller)
+type ErrorParameter struct {
+	TypeName   TypeName
+	Identifier *Identifier
+	Comma      *token.Pos
+}
+
+func (e ErrorParameter) Pos() token.Pos { return e.TypeName.Pos() }
+func (e ErrorParameter) End() token.Pos {
+	if e.Comma != nil {
+		return *e.Comma
+	}
+	if e.Identifier != nil {
+		return e.Identifier.End()
+	}
+	return e.TypeName.End()
+}
+
+// error Identifier ( Parameters ) ; (e.g. error Unauthorized(address caller);)
+type ErrorDefinition struct {
+	Error      token.Pos
+	Identifier Identifier
+	LParen     token.Pos
+	Parameters []*ErrorParameter
+	RParen     token.Pos
+	Semicolon  token.Pos
+}
+
+func (e ErrorDefinition) Pos() token.Pos { return e.Error }
+func (e ErrorDefinition) End() token.Pos { return e.Semicolon }
+
+func (f *FunctionDefinition) contractBodyElementNode()             {}
+func (m *ModifierDefinition) contractBodyElementNode()             {}
+func (s *StateVariableDeclaration) contractBodyElementNode()       {}
+func (s *StructDefinition) contractBodyElementNode()               {}
+func (e *EnumDefinition) contractBodyElementNode()                 {}
+func (u *UserDefinedValueTypeDefinition) contractBodyElementNode() {}
+func (e *EventDefinition) contractBodyElementNode()                {}
+func (e *ErrorDefinition) contractBodyElementNode()                {}
+func (u *UsingDirective) contractBodyElementNode()                 {}
+func (c *ConstructorDefinition) contractBodyElementNode()          {}
+func (f *FallbackFunctionDefinition) contractBodyElementNode()     {}
+func (r *ReceiveFunctionDefinition) contractBodyElementNode()      {}
 
 // ----------------------------------------------------------------------------
 
+// IdentifierPath CallArgumentList (e.g. ERC20("Token", "TKN"), Ownable)
 type InheritanceSpecifier struct {
 	IdentifierPath   IdentifierPath
 	CallArgumentList *CallArgumentList
+	Comma            *token.Pos
+}
+
+func (i InheritanceSpecifier) Pos() token.Pos { return i.IdentifierPath.Pos() }
+func (i InheritanceSpecifier) End() token.Pos {
+	if i.Comma != nil {
+		return *i.Comma
+	}
+	if i.CallArgumentList != nil {
+		return i.CallArgumentList.End()
+	}
+	return i.IdentifierPath.End()
 }
 
 // ----------------------------------------------------------------------------
@@ -251,6 +681,9 @@ type CallArgumentList struct {
 	RParen   token.Pos
 }
 
+func (c CallArgumentList) Pos() token.Pos { return c.LParen }
+func (c CallArgumentList) End() token.Pos { return c.RParen }
+
 type IdentifierPathElement struct {
 	Identifier Identifier
 	Period     *token.Pos
@@ -262,41 +695,165 @@ type IdentifierPath struct {
 func (i IdentifierPath) Pos() token.Pos { return i.Elements[0].Identifier.Pos() }
 func (i IdentifierPath) End() token.Pos { return i.Elements[len(i.Elements)-1].Identifier.End() }
 
+// ContractKind is the kind of a contract definition.
+type ContractKind int
+
+const (
+	ContractKindContract ContractKind = iota
+	ContractKindInterface
+	ContractKindLibrary
+)
+
+func (k ContractKind) String() string {
+	switch k {
+	case ContractKindContract:
+		return "contract"
+	case ContractKindInterface:
+		return "interface"
+	case ContractKindLibrary:
+		return "library"
+	}
+	return "unknown"
+}
+
+// contract, interface and library definitions.
+// Abstract is not nil only if Kind is ContractKindContract.
 type ContractDefinition struct {
-	Abstract             *token.Pos
-	Contract             token.Pos
-	Identifier           Identifier
-	LBrace               token.Pos
-	ContractBodyElements []ContractBodyElement
-	RBrace               token.Pos
+	Abstract              *token.Pos
+	Kind                  ContractKind
+	Contract              token.Pos // position of the contract, interface or library keyword
+	Identifier            Identifier
+	Is                    *token.Pos
+	InheritanceSpecifiers []*InheritanceSpecifier
+	LBrace                token.Pos
+	ContractBodyElements  []ContractBodyElement
+	RBrace                token.Pos
 }
 
 // A File node represents a Solidity source file.
 type SourceUnit struct {
 	PragmaDirective    *PragmaDirective
 	ImportDirective    *ImportDirective
-	ContractDefinition *ContractDefinition
 	FunctionDefinition *FunctionDefinition
+
+	ContractDefinitions             []*ContractDefinition
+	StructDefinitions               []*StructDefinition
+	EnumDefinitions                 []*EnumDefinition
+	UserDefinedValueTypeDefinitions []*UserDefinedValueTypeDefinition
+	EventDefinitions                []*EventDefinition
+	ErrorDefinitions                []*ErrorDefinition
+	UsingDirectives                 []*UsingDirective
 }
 
 // ----------------------------------------------------------------------------
 // TypeName Nodes
 
-type ElementaryTypeName []*token.Token
+// ElementaryTypeNameKind is the kind of an elementary type name.
+type ElementaryTypeNameKind int
+
+const (
+	ElementaryTypeNameKindAddress    ElementaryTypeNameKind = iota // address, address payable
+	ElementaryTypeNameKindBool                                     // bool
+	ElementaryTypeNameKindString                                   // string
+	ElementaryTypeNameKindBytes                                    // bytes
+	ElementaryTypeNameKindFixedBytes                               // bytes1 ~ bytes32, byte
+	ElementaryTypeNameKindInt                                      // int8 ~ int256, int
+	ElementaryTypeNameKindUint                                     // uint8 ~ uint256, uint
+	ElementaryTypeNameKindFixed                                    // fixedMxN, fixed
+	ElementaryTypeNameKindUfixed                                   // ufixedMxN, ufixed
+)
+
+// ElementaryTypeName is a built-in type name. (e.g. uint256, address payable, bytes32, fixed128x18)
+// Bits is the size in bits of the fixed bytes, integer and fixed point types, with the default size applied
+// (e.g. 256 for uint, 32 for bytes4, 128 for fixed). It is 0 for the other kinds.
+// Decimals is the number of decimal points of the fixed point types (e.g. 18 for fixed).
+type ElementaryTypeName struct {
+	Token    token.Token
+	Payable  *token.Pos // not nil if address payable
+	Kind     ElementaryTypeNameKind
+	Bits     int
+	Decimals int
+}
 
 func (e ElementaryTypeName) Pos() token.Pos {
-	return e[0].Position
+	return e.Token.Position
 }
 
 func (e ElementaryTypeName) End() token.Pos {
-	eTkn := e[len(e)-1]
+	if e.Payable != nil {
+		return token.Pos{
+			Column: e.Payable.Column + len("payable"),
+			Line:   e.Payable.Line,
+		}
+	}
 	return token.Pos{
-		Column: eTkn.Position.Column + len(eTkn.Value),
-		Line:   eTkn.Position.Line,
+		Column: e.Token.Position.Column + len(e.Token.Value),
+		Line:   e.Token.Position.Line,
+	}
+}
+
+// mapping ( KeyType KeyName => ValueType ValueName ) (e.g. mapping(address owner => uint256 balance))
+type MappingType struct {
+	Mapping   token.Pos
+	LParen    token.Pos
+	KeyType   TypeName
+	KeyName   *Identifier
+	Arrow     token.Pos
+	ValueType TypeName
+	ValueName *Identifier
+	RParen    token.Pos
+}
+
+func (m MappingType) Pos() token.Pos { return m.Mapping }
+func (m MappingType) End() token.Pos { return m.RParen }
+
+// TypeName [ Length ] (e.g. uint[], bytes32[4])
+type ArrayTypeName struct {
+	TypeName TypeName
+	LBrack   token.Pos
+	Length   Expression // nil if the array is dynamically-sized
+	RBrack   token.Pos
+}
+
+func (a ArrayTypeName) Pos() token.Pos { return a.TypeName.Pos() }
+func (a ArrayTypeName) End() token.Pos { return a.RBrack }
+
+// function ( Parameters ) ModifierList Returns (e.g. function (uint) external view returns (bool))
+// ModifierList only contains visibility and state mutability.
+type FunctionTypeName struct {
+	Function     token.Pos
+	LParen       token.Pos
+	Parameters   ParameterList
+	RParen       token.Pos
+	ModifierList *ModifierList
+	Returns      *FunctionDefinitionReturns
+}
+
+func (f FunctionTypeName) Pos() token.Pos { return f.Function }
+func (f FunctionTypeName) End() token.Pos {
+	if f.Returns != nil {
+		return f.Returns.RParen
+	}
+	if f.ModifierList != nil && len(f.ModifierList.Elements) > 0 {
+		return f.ModifierList.Elements[len(f.ModifierList.Elements)-1].End()
 	}
+	return f.RParen
 }
 
-func (e ElementaryTypeName) typeNameNode() {}
+// IdentifierPath as a type name, which refers to a contract, struct, enum or user-defined value type.
+// (e.g. IERC20, Lib.Struct)
+type UserDefinedTypeName struct {
+	IdentifierPath IdentifierPath
+}
+
+func (u UserDefinedTypeName) Pos() token.Pos { return u.IdentifierPath.Pos() }
+func (u UserDefinedTypeName) End() token.Pos { return u.IdentifierPath.End() }
+
+func (e ElementaryTypeName) typeNameNode()   {}
+func (m *MappingType) typeNameNode()         {}
+func (a *ArrayTypeName) typeNameNode()       {}
+func (f *FunctionTypeName) typeNameNode()    {}
+func (u *UserDefinedTypeName) typeNameNode() {}
 
 // ----------------------------------------------------------------------------
 // Expression Nodes
@@ -313,6 +870,182 @@ func (i Identifier) End() token.Pos {
 
 func (i *Identifier) expressionNode() {}
 
+// Left Operator Right (e.g. a + b, a && b)
+type BinaryExpression struct {
+	Left     Expression
+	Operator token.Token
+	Right    Expression
+}
+
+func (b BinaryExpression) Pos() token.Pos { return b.Left.Pos() }
+func (b BinaryExpression) End() token.Pos { return b.Right.End() }
+
+// Operator Operand (e.g. !a, -a, ++a, delete a) or Operand Operator (e.g. a++, a--)
+type UnaryExpression struct {
+	Operator token.Token
+	Operand  Expression
+	Postfix  bool
+}
+
+func (u UnaryExpression) Pos() token.Pos {
+	if u.Postfix {
+		return u.Operand.Pos()
+	}
+	return u.Operator.Position
+}
+
+func (u UnaryExpression) End() token.Pos {
+	if u.Postfix {
+		return token.Pos{
+			Column: u.Operator.Position.Column + len(u.Operator.Value),
+			Line:   u.Operator.Position.Line,
+		}
+	}
+	return u.Operand.End()
+}
+
+// Condition ? TrueExpression : FalseExpression
+type ConditionalExpression struct {
+	Condition       Expression
+	Question        token.Pos
+	TrueExpression  Expression
+	Colon           token.Pos
+	FalseExpression Expression
+}
+
+func (c ConditionalExpression) Pos() token.Pos { return c.Condition.Pos() }
+func (c ConditionalExpression) End() token.Pos { return c.FalseExpression.End() }
+
+// Left Operator Right (e.g. a = b, a += b, a >>= b)
+type AssignmentExpression struct {
+	Left     Expression
+	Operator token.Token
+	Right    Expression
+}
+
+func (a AssignmentExpression) Pos() token.Pos { return a.Left.Pos() }
+func (a AssignmentExpression) End() token.Pos { return a.Right.End() }
+
+// Expression . Member
+type MemberAccess struct {
+	Expression Expression
+	Period     token.Pos
+	Member     Identifier
+}
+
+func (m MemberAccess) Pos() token.Pos { return m.Expression.Pos() }
+func (m MemberAccess) End() token.Pos { return m.Member.End() }
+
+// Expression [ Index ]
+type IndexAccess struct {
+	Expression Expression
+	LBrack     token.Pos
+	Index      Expression // nil if omitted (e.g. uint[])
+	RBrack     token.Pos
+}
+
+func (i IndexAccess) Pos() token.Pos { return i.Expression.Pos() }
+func (i IndexAccess) End() token.Pos { return i.RBrack }
+
+// Expression [ StartExpression : EndExpression ]
+type IndexRangeAccess struct {
+	Expression      Expression
+	LBrack          token.Pos
+	StartExpression Expression // nil if omitted
+	Colon           token.Pos
+	EndExpression   Expression // nil if omitted
+	RBrack          token.Pos
+}
+
+func (i IndexRangeAccess) Pos() token.Pos { return i.Expression.Pos() }
+func (i IndexRangeAccess) End() token.Pos { return i.RBrack }
+
+// Expression { Options }
+type FunctionCallOptions struct {
+	Expression Expression
+	Options    *CallArgumentListNamedExpretions
+}
+
+func (f FunctionCallOptions) Pos() token.Pos { return f.Expression.Pos() }
+func (f FunctionCallOptions) End() token.Pos { return f.Options.End() }
+
+// Expression ( CallArgumentList )
+type FunctionCall struct {
+	Expression       Expression
+	CallArgumentList *CallArgumentList
+}
+
+func (f FunctionCall) Pos() token.Pos { return f.Expression.Pos() }
+func (f FunctionCall) End() token.Pos { return f.CallArgumentList.End() }
+
+type TupleComponent struct {
+	Expression Expression // nil if the component is empty (e.g. (a, , c))
+	Comma      *token.Pos
+}
+
+// ( Components ) (e.g. (a, b), (a, , c), (a))
+type TupleExpression struct {
+	LParen     token.Pos
+	Components []*TupleComponent
+	RParen     token.Pos
+}
+
+func (t TupleExpression) Pos() token.Pos { return t.LParen }
+func (t TupleExpression) End() token.Pos { return t.RParen }
+
+// [ Expressions ] (e.g. [1, 2, 3])
+type InlineArrayExpression struct {
+	LBrack      token.Pos
+	Expressions CallArgumentListExpretions
+	RBrack      token.Pos
+}
+
+func (i InlineArrayExpression) Pos() token.Pos { return i.LBrack }
+func (i InlineArrayExpression) End() token.Pos { return i.RBrack }
+
+// new TypeName (e.g. new Foo, new uint[])
+type NewExpression struct {
+	New      token.Pos
+	TypeName TypeName
+}
+
+func (n NewExpression) Pos() token.Pos { return n.New }
+func (n NewExpression) End() token.Pos { return n.TypeName.End() }
+
+// type ( TypeName ) (e.g. type(IERC20))
+type MetaTypeExpression struct {
+	Type     token.Pos
+	LParen   token.Pos
+	TypeName TypeName
+	RParen   token.Pos
+}
+
+func (m MetaTypeExpression) Pos() token.Pos { return m.Type }
+func (m MetaTypeExpression) End() token.Pos { return m.RParen }
+
+// ElementaryTypeName or payable used as an expression. (e.g. address in address(x), payable in payable(x))
+type ElementaryTypeNameExpression struct {
+	TypeName ElementaryTypeName
+}
+
+func (e ElementaryTypeNameExpression) Pos() token.Pos { return e.TypeName.Pos() }
+func (e ElementaryTypeNameExpression) End() token.Pos { return e.TypeName.End() }
+
+func (b *BinaryExpression) expressionNode()             {}
+func (u *UnaryExpression) expressionNode()              {}
+func (c *ConditionalExpression) expressionNode()        {}
+func (a *AssignmentExpression) expressionNode()         {}
+func (m *MemberAccess) expressionNode()                 {}
+func (i *IndexAccess) expressionNode()                  {}
+func (i *IndexRangeAccess) expressionNode()             {}
+func (f *FunctionCallOptions) expressionNode()          {}
+func (f *FunctionCall) expressionNode()                 {}
+func (t *TupleExpression) expressionNode()              {}
+func (i *InlineArrayExpression) expressionNode()        {}
+func (n *NewExpression) expressionNode()                {}
+func (m *MetaTypeExpression) expressionNode()           {}
+func (e *ElementaryTypeNameExpression) expressionNode() {}
+
 // ----------------------------------------------------------------------------
 // Literal Nodes
 
@@ -338,46 +1071,82 @@ func (s StringLiteral) Pos() token.Pos {
 	return s.Position
 }
 
-func (s StringLiteral) End() (pos token.Pos) {
+func (s StringLiteral) End() token.Pos {
+	return quotedEnd(s.Position, s.Value)
+}
+
+// quotedEnd returns the position of the closing quote of the quoted literal value which begins at start.
+func quotedEnd(start token.Pos, value string) (pos token.Pos) {
 	// Calculate Line and Offset by referring to the number of new line codes
-	nc := strings.Count(s.Value, "\n")
+	nc := strings.Count(value, "\n")
 
-	pos.Line = s.Position.Line + nc
+	pos.Line = start.Line + nc
 	if nc == 0 {
-		pos.Column = s.Position.Column + len(s.Value) - 1
+		pos.Column = start.Column + utf8.RuneCountInString(value) - 1
 		return
 	}
 
-	pos.Column = len(s.Value) - strings.LastIndexByte(s.Value, '\n') - 1
+	pos.Column = utf8.RuneCountInString(value[strings.LastIndexByte(value, '\n')+1:])
 	return
 }
 
+// StringLiteral StringLiteral ... (e.g. "abc" "def")
+type ConcatenatedStringLiteral []*StringLiteral
+
+func (c ConcatenatedStringLiteral) Pos() token.Pos { return c[0].Pos() }
+func (c ConcatenatedStringLiteral) End() token.Pos { return c[len(c)-1].End() }
+
 type HexStringLiteral []*HexString
 
+func (h HexStringLiteral) Pos() token.Pos { return h[0].Pos() }
+func (h HexStringLiteral) End() token.Pos { return h[len(h)-1].End() }
+
 type UnicordStringLiteral []*UnicordStrings
 
+func (u UnicordStringLiteral) Pos() token.Pos { return u[0].Pos() }
+func (u UnicordStringLiteral) End() token.Pos { return u[len(u)-1].End() }
+
 type NumberLiteral struct {
-	Number     Node // DecimalNumber | HexNumber
+	Number     token.Token // DecimalNumber | HexNumber
 	NumberUnit *NumberUnit
 }
 
-func (*BooleanLiteral) literalNode()       {}
-func (*StringLiteral) literalNode()        {}
-func (*HexStringLiteral) literalNode()     {}
-func (*UnicordStringLiteral) literalNode() {}
-func (*NumberLiteral) literalNode()        {}
+func (n NumberLiteral) Pos() token.Pos { return n.Number.Position }
+func (n NumberLiteral) End() token.Pos {
+	if n.NumberUnit != nil {
+		return n.NumberUnit.End()
+	}
+	return token.Pos{
+		Column: n.Number.Position.Column + len(n.Number.Value),
+		Line:   n.Number.Position.Line,
+	}
+}
 
-func (*BooleanLiteral) expressionNode()       {}
-func (*StringLiteral) expressionNode()        {}
-func (*HexStringLiteral) expressionNode()     {}
-func (*UnicordStringLiteral) expressionNode() {}
-func (*NumberLiteral) expressionNode()        {}
+func (*BooleanLiteral) literalNode()            {}
+func (*StringLiteral) literalNode()             {}
+func (*ConcatenatedStringLiteral) literalNode() {}
+func (*HexStringLiteral) literalNode()          {}
+func (*UnicordStringLiteral) literalNode()      {}
+func (*NumberLiteral) literalNode()             {}
+
+func (*BooleanLiteral) expressionNode()            {}
+func (*StringLiteral) expressionNode()             {}
+func (*ConcatenatedStringLiteral) expressionNode() {}
+func (*HexStringLiteral) expressionNode()          {}
+func (*UnicordStringLiteral) expressionNode()      {}
+func (*NumberLiteral) expressionNode()             {}
 
 // ----------------------------------------------------------------------------
 
-type NumberUnit struct {
-	Pos   token.Pos
-	Value string
+// wei | gwei | ether | seconds | minutes | hours | days | weeks | years
+type NumberUnit token.Token
+
+func (n NumberUnit) Pos() token.Pos { return n.Position }
+func (n NumberUnit) End() token.Pos {
+	return token.Pos{
+		Column: n.Position.Column + len(n.Value),
+		Line:   n.Position.Line,
+	}
 }
 
 type EmptyStringLiteral struct {
@@ -453,23 +1222,24 @@ func (*EscapeSequence) printableNode()        {}
 // ----------------------------------------------------------------------------
 
 // unicode-string-literal (https://github.com/ethereum/solidity/blob/develop/docs/grammar/SolidityParser.g4#L407)
-type UnicordStrings struct {
-	Pos  token.Pos
-	List []Node // string | EscapeSequence
-}
+// Value includes the unicode prefix and quotes. (e.g. unicode"Hello 😃")
+type UnicordStrings token.Token
 
-type HexString struct {
-	Pos          token.Pos
-	SingleQuoted bool
-	String       string
-}
+func (u UnicordStrings) Pos() token.Pos { return u.Position }
+func (u UnicordStrings) End() token.Pos { return quotedEnd(u.Position, u.Value) }
+
+// Value includes the hex prefix and quotes. (e.g. hex"00ff")
+type HexString token.Token
+
+func (h HexString) Pos() token.Pos { return h.Position }
+func (h HexString) End() token.Pos { return quotedEnd(h.Position, h.Value) }
 
 // ----------------------------------------------------------------------------
 
 type Block struct {
 	LBracePos token.Pos
 	RBracePos token.Pos
-	Nodes     []Node // statement | unblocked-block
+	Nodes     []Statement // statement | unchecked-block
 }
 
 func (b Block) Pos() token.Pos {
@@ -480,16 +1250,272 @@ func (b Block) End() token.Pos {
 	return b.RBracePos
 }
 
+func (b *Block) statementNode() {}
+
+// unchecked Block
+type UncheckedBlock struct {
+	Unchecked token.Pos
+	Block     *Block
+}
+
+func (u UncheckedBlock) Pos() token.Pos { return u.Unchecked }
+func (u UncheckedBlock) End() token.Pos { return u.Block.End() }
+
+func (u *UncheckedBlock) statementNode() {}
+
 // ----------------------------------------------------------------------------
 // Statement Nodes
 
 type ReturnStatement struct {
 	From       token.Pos
 	SemiPos    token.Pos
-	Expression Expression
+	Expression Expression // nil if omitted (e.g. return;)
 }
 
 func (r ReturnStatement) Pos() token.Pos { return r.From }
 func (r ReturnStatement) End() token.Pos { return r.SemiPos }
 
-func (s *ReturnStatement) statementNode() {}
+// Expression ;
+type ExpressionStatement struct {
+	Expression Expression
+	Semicolon  token.Pos
+}
+
+func (e ExpressionStatement) Pos() token.Pos { return e.Expression.Pos() }
+func (e ExpressionStatement) End() token.Pos { return e.Semicolon }
+
+// if ( Condition ) TrueBody else FalseBody
+type IfStatement struct {
+	If        token.Pos
+	LParen    token.Pos
+	Condition Expression
+	RParen    token.Pos
+	TrueBody  Statement
+	Else      *token.Pos
+	FalseBody Statement // nil if else is omitted
+}
+
+func (i IfStatement) Pos() token.Pos { return i.If }
+func (i IfStatement) End() token.Pos {
+	if i.FalseBody != nil {
+		return i.FalseBody.End()
+	}
+	return i.TrueBody.End()
+}
+
+// for ( Init Condition Post ) Body
+// Init and Condition include their semicolons.
+// If they are omitted, InitSemicolon and ConditionSemicolon hold the positions of the semicolons instead.
+type ForStatement struct {
+	For                token.Pos
+	LParen             token.Pos
+	Init               Statement // nil if omitted
+	InitSemicolon      *token.Pos
+	Condition          *ExpressionStatement // nil if omitted
+	ConditionSemicolon *token.Pos
+	Post               Expression // nil if omitted
+	RParen             token.Pos
+	Body               Statement
+}
+
+func (f ForStatement) Pos() token.Pos { return f.For }
+func (f ForStatement) End() token.Pos { return f.Body.End() }
+
+// while ( Condition ) Body
+type WhileStatement struct {
+	While     token.Pos
+	LParen    token.Pos
+	Condition Expression
+	RParen    token.Pos
+	Body      Statement
+}
+
+func (w WhileStatement) Pos() token.Pos { return w.While }
+func (w WhileStatement) End() token.Pos { return w.Body.End() }
+
+// do Body while ( Condition ) ;
+type DoWhileStatement struct {
+	Do        token.Pos
+	Body      Statement
+	While     token.Pos
+	LParen    token.Pos
+	Condition Expression
+	RParen    token.Pos
+	Semicolon token.Pos
+}
+
+func (d DoWhileStatement) Pos() token.Pos { return d.Do }
+func (d DoWhileStatement) End() token.Pos { return d.Semicolon }
+
+type ContinueStatement struct {
+	Continue  token.Pos
+	Semicolon token.Pos
+}
+
+func (c ContinueStatement) Pos() token.Pos { return c.Continue }
+func (c ContinueStatement) End() token.Pos { return c.Semicolon }
+
+type BreakStatement struct {
+	Break     token.Pos
+	Semicolon token.Pos
+}
+
+func (b BreakStatement) Pos() token.Pos { return b.Break }
+func (b BreakStatement) End() token.Pos { return b.Semicolon }
+
+// _ ; in the body of a modifier definition.
+type PlaceholderStatement struct {
+	Placeholder token.Pos
+	Semicolon   token.Pos
+}
+
+func (p PlaceholderStatement) Pos() token.Pos { return p.Placeholder }
+func (p PlaceholderStatement) End() token.Pos { return p.Semicolon }
+
+// emit Event CallArgumentList ;
+type EmitStatement struct {
+	Emit             token.Pos
+	Event            IdentifierPath
+	CallArgumentList *CallArgumentList
+	Semicolon        token.Pos
+}
+
+func (e EmitStatement) Pos() token.Pos { return e.Emit }
+func (e EmitStatement) End() token.Pos { return e.Semicolon }
+
+// revert Error CallArgumentList ;
+// revert(...) without an error name is parsed as an ExpressionStatement calling revert.
+type RevertStatement struct {
+	Revert           token.Pos
+	Error            IdentifierPath
+	CallArgumentList *CallArgumentList
+	Semicolon        token.Pos
+}
+
+func (r RevertStatement) Pos() token.Pos { return r.Revert }
+func (r RevertStatement) End() token.Pos { return r.Semicolon }
+
+// TypeName DataLocation Identifier (e.g. uint x, bytes memory b)
+type VariableDeclaration struct {
+	TypeName     TypeName
+	DataLocation *token.Token // memory | storage | calldata
+	Identifier   Identifier
+}
+
+func (v VariableDeclaration) Pos() token.Pos { return v.TypeName.Pos() }
+func (v VariableDeclaration) End() token.Pos { return v.Identifier.End() }
+
+// VariableDeclaration = InitialValue ;
+type VariableDeclarationStatement struct {
+	VariableDeclaration *VariableDeclaration
+	Assign              *token.Pos
+	InitialValue        Expression // nil if omitted
+	Semicolon           token.Pos
+}
+
+func (v VariableDeclarationStatement) Pos() token.Pos { return v.VariableDeclaration.Pos() }
+func (v VariableDeclarationStatement) End() token.Pos { return v.Semicolon }
+
+type VariableDeclarationTupleComponent struct {
+	VariableDeclaration *VariableDeclaration // nil if the component is empty (e.g. (uint a, , uint c))
+	Comma               *token.Pos
+}
+
+// ( Components ) = InitialValue ;
+type VariableDeclarationTupleStatement struct {
+	LParen       token.Pos
+	Components   []*VariableDeclarationTupleComponent
+	RParen       token.Pos
+	Assign       token.Pos
+	InitialValue Expression
+	Semicolon    token.Pos
+}
+
+func (v VariableDeclarationTupleStatement) Pos() token.Pos { return v.LParen }
+func (v VariableDeclarationTupleStatement) End() token.Pos { return v.Semicolon }
+
+// try Expression returns ( ParameterList ) Block CatchClauses
+type TryStatement struct {
+	Try          token.Pos
+	Expression   Expression
+	Returns      *FunctionDefinitionReturns
+	Block        *Block
+	CatchClauses []*CatchClause
+}
+
+func (t TryStatement) Pos() token.Pos { return t.Try }
+func (t TryStatement) End() token.Pos { return t.CatchClauses[len(t.CatchClauses)-1].End() }
+
+// CatchClauseKind is the kind of errors which a catch clause catches.
+type CatchClauseKind int
+
+const (
+	CatchClauseKindAll      CatchClauseKind = iota // catch { }
+	CatchClauseKindError                           // catch Error(string memory reason) { }
+	CatchClauseKindPanic                           // catch Panic(uint code) { }
+	CatchClauseKindLowLevel                        // catch (bytes memory data) { }
+)
+
+func (k CatchClauseKind) String() string {
+	switch k {
+	case CatchClauseKindAll:
+		return "all"
+	case CatchClauseKindError:
+		return "Error"
+	case CatchClauseKindPanic:
+		return "Panic"
+	case CatchClauseKindLowLevel:
+		return "low-level"
+	}
+	return "unknown"
+}
+
+// catch Identifier ( ParameterList ) Block
+// Identifier is Error or Panic for the clauses catching revert reasons or panic codes,
+// and nil for the clause catching all the others. (e.g. catch (bytes memory data) { }, catch { })
+type CatchClause struct {
+	Catch         token.Pos
+	Kind          CatchClauseKind
+	Identifier    *Identifier
+	LParen        *token.Pos
+	ParameterList ParameterList
+	RParen        *token.Pos
+	Block         *Block
+}
+
+func (c CatchClause) Pos() token.Pos { return c.Catch }
+func (c CatchClause) End() token.Pos { return c.Block.End() }
+
+type InlineAssemblyFlag struct {
+	Flag  *StringLiteral
+	Comma *token.Pos
+}
+
+// assembly Dialect ( Flags ) Block (e.g. assembly "evmasm" ("memory-safe") { })
+type InlineAssemblyStatement struct {
+	Assembly token.Pos
+	Dialect  *StringLiteral // nil if omitted
+	LParen   *token.Pos
+	Flags    []*InlineAssemblyFlag
+	RParen   *token.Pos
+	Block    *yul.Block
+}
+
+func (i InlineAssemblyStatement) Pos() token.Pos { return i.Assembly }
+func (i InlineAssemblyStatement) End() token.Pos { return i.Block.End() }
+
+func (s *ReturnStatement) statementNode()                   {}
+func (e *ExpressionStatement) statementNode()               {}
+func (i *IfStatement) statementNode()                       {}
+func (f *ForStatement) statementNode()                      {}
+func (w *WhileStatement) statementNode()                    {}
+func (d *DoWhileStatement) statementNode()                  {}
+func (c *ContinueStatement) statementNode()                 {}
+func (b *BreakStatement) statementNode()                    {}
+func (e *EmitStatement) statementNode()                     {}
+func (r *RevertStatement) statementNode()                   {}
+func (t *TryStatement) statementNode()                      {}
+func (i *InlineAssemblyStatement) statementNode()           {}
+func (v *VariableDeclarationStatement) statementNode()      {}
+func (v *VariableDeclarationTupleStatement) statementNode() {}
+func (p *PlaceholderStatement) statementNode()              {}
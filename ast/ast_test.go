@@ -18,12 +18,76 @@ var (
 	_ ast.Node                   = &ast.SymbolAliases{}
 	_ ast.Node                   = &ast.PragmaDirective{}
 	_ ast.ContractBodyElement    = &ast.FunctionDefinition{}
+	_ ast.ContractBodyElement    = &ast.ConstructorDefinition{}
+	_ ast.ContractBodyElement    = &ast.ModifierDefinition{}
+	_ ast.ContractBodyElement    = &ast.StateVariableDeclaration{}
+	_ ast.ContractBodyElement    = &ast.StructDefinition{}
+	_ ast.ContractBodyElement    = &ast.EnumDefinition{}
+	_ ast.ContractBodyElement    = &ast.UserDefinedValueTypeDefinition{}
+	_ ast.Node                   = &ast.StructMember{}
+	_ ast.ContractBodyElement    = &ast.EventDefinition{}
+	_ ast.ContractBodyElement    = &ast.ErrorDefinition{}
+	_ ast.Node                   = &ast.EventParameter{}
+	_ ast.Node                   = &ast.ErrorParameter{}
+	_ ast.ContractBodyElement    = &ast.UsingDirective{}
+	_ ast.Node                   = &ast.UsingAlias{}
+	_ ast.Node                   = &ast.UsingAliases{}
+	_ ast.Node                   = &ast.InheritanceSpecifier{}
+	_ ast.ContractBodyElement    = &ast.FallbackFunctionDefinition{}
+	_ ast.ContractBodyElement    = &ast.ReceiveFunctionDefinition{}
+	_ ast.Node                   = &ast.Parameter{}
+	_ ast.ModifierListElement    = &ast.Visibility{}
+	_ ast.ModifierListElement    = &ast.StateMutability{}
+	_ ast.ModifierListElement    = &ast.Virtual{}
+	_ ast.ModifierListElement    = &ast.OverrideSpecifier{}
+	_ ast.ModifierListElement    = &ast.ModifierInvocation{}
 	_ ast.TypeName               = ast.ElementaryTypeName{}
+	_ ast.TypeName               = &ast.MappingType{}
+	_ ast.TypeName               = &ast.ArrayTypeName{}
+	_ ast.TypeName               = &ast.FunctionTypeName{}
+	_ ast.TypeName               = &ast.UserDefinedTypeName{}
 	_ ast.Expression             = &ast.Identifier{}
+	_ ast.Expression             = &ast.BinaryExpression{}
+	_ ast.Expression             = &ast.UnaryExpression{}
+	_ ast.Expression             = &ast.ConditionalExpression{}
+	_ ast.Expression             = &ast.AssignmentExpression{}
+	_ ast.Expression             = &ast.MemberAccess{}
+	_ ast.Expression             = &ast.IndexAccess{}
+	_ ast.Expression             = &ast.IndexRangeAccess{}
+	_ ast.Expression             = &ast.FunctionCallOptions{}
+	_ ast.Expression             = &ast.FunctionCall{}
+	_ ast.Expression             = &ast.TupleExpression{}
+	_ ast.Expression             = &ast.InlineArrayExpression{}
+	_ ast.Expression             = &ast.NewExpression{}
+	_ ast.Expression             = &ast.MetaTypeExpression{}
+	_ ast.Expression             = &ast.ElementaryTypeNameExpression{}
 	_ ast.Literal                = &ast.BooleanLiteral{}
 	_ ast.Literal                = &ast.StringLiteral{}
+	_ ast.Literal                = &ast.NumberLiteral{}
+	_ ast.Literal                = &ast.ConcatenatedStringLiteral{}
+	_ ast.Literal                = &ast.HexStringLiteral{}
+	_ ast.Literal                = &ast.UnicordStringLiteral{}
+	_ ast.Node                   = &ast.NumberUnit{}
 	_ ast.Node                   = &ast.Block{}
+	_ ast.Statement              = &ast.Block{}
+	_ ast.Statement              = &ast.UncheckedBlock{}
 	_ ast.Statement              = &ast.ReturnStatement{}
+	_ ast.Statement              = &ast.ExpressionStatement{}
+	_ ast.Statement              = &ast.IfStatement{}
+	_ ast.Statement              = &ast.ForStatement{}
+	_ ast.Statement              = &ast.WhileStatement{}
+	_ ast.Statement              = &ast.DoWhileStatement{}
+	_ ast.Statement              = &ast.ContinueStatement{}
+	_ ast.Statement              = &ast.BreakStatement{}
+	_ ast.Statement              = &ast.EmitStatement{}
+	_ ast.Statement              = &ast.RevertStatement{}
+	_ ast.Statement              = &ast.TryStatement{}
+	_ ast.Statement              = &ast.InlineAssemblyStatement{}
+	_ ast.Statement              = &ast.PlaceholderStatement{}
+	_ ast.Node                   = &ast.CatchClause{}
+	_ ast.Statement              = &ast.VariableDeclarationStatement{}
+	_ ast.Statement              = &ast.VariableDeclarationTupleStatement{}
+	_ ast.Node                   = &ast.VariableDeclaration{}
 )
 
 func TestNode_End(t *testing.T) {
@@ -104,6 +168,129 @@ func TestNode_End(t *testing.T) {
 				Line:   5,
 			},
 		},
+		{
+			name: "HexStringLiteral",
+			node: &ast.HexStringLiteral{
+				{
+					Type:     token.HexString,
+					Value:    `hex"00"`,
+					Position: token.Pos{Column: 4, Line: 3},
+				},
+				{
+					Type:     token.HexString,
+					Value:    `hex"00ff"`,
+					Position: token.Pos{Column: 12, Line: 3},
+				},
+			},
+			exptEnd: token.Pos{
+				Column: 20,
+				Line:   3,
+			},
+		},
+		{
+			name: "UnicordStringLiteral",
+			node: &ast.UnicordStringLiteral{
+				{
+					Type:     token.UnicodeStringLiteral,
+					Value:    `unicode"😃"`,
+					Position: token.Pos{Column: 4, Line: 3},
+				},
+			},
+			exptEnd: token.Pos{
+				Column: 13,
+				Line:   3,
+			},
+		},
+		{
+			name: "Postfix UnaryExpression",
+			node: &ast.UnaryExpression{
+				Operator: token.Token{
+					Type:     token.Inc,
+					Value:    "++",
+					Position: token.Pos{Column: 5, Line: 3},
+				},
+				Operand: &ast.Identifier{
+					Type:     token.Identifier,
+					Value:    "test",
+					Position: token.Pos{Column: 1, Line: 3},
+				},
+				Postfix: true,
+			},
+			exptEnd: token.Pos{
+				Column: 7,
+				Line:   3,
+			},
+		},
+		{
+			name: "NewExpression",
+			node: &ast.NewExpression{
+				New: token.Pos{Column: 1, Line: 3},
+				TypeName: ast.ElementaryTypeName{
+					Token: token.Token{
+						Type:     token.String,
+						Value:    "string",
+						Position: token.Pos{Column: 5, Line: 3},
+					},
+					Kind: ast.ElementaryTypeNameKindString,
+				},
+			},
+			exptEnd: token.Pos{
+				Column: 11,
+				Line:   3,
+			},
+		},
+		{
+			name: "ElementaryTypeName address payable",
+			node: ast.ElementaryTypeName{
+				Token: token.Token{
+					Type:     token.Address,
+					Value:    "address",
+					Position: token.Pos{Column: 1, Line: 3},
+				},
+				Payable: &token.Pos{Column: 9, Line: 3},
+			},
+			exptEnd: token.Pos{
+				Column: 16,
+				Line:   3,
+			},
+		},
+		{
+			name: "FunctionTypeName without returns",
+			node: &ast.FunctionTypeName{
+				Function: token.Pos{Column: 1, Line: 3},
+				RParen:   token.Pos{Column: 11, Line: 3},
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{Type: token.External, Value: "external", Position: token.Pos{Column: 13, Line: 3}},
+					},
+				},
+			},
+			exptEnd: token.Pos{
+				Column: 21,
+				Line:   3,
+			},
+		},
+		{
+			name: "OverrideSpecifier without paths",
+			node: &ast.OverrideSpecifier{
+				Override: token.Pos{Column: 4, Line: 3},
+			},
+			exptEnd: token.Pos{
+				Column: 12,
+				Line:   3,
+			},
+		},
+		{
+			name: "FunctionDefinition without body",
+			node: &ast.FunctionDefinition{
+				From:      token.Pos{Column: 1, Line: 3},
+				Semicolon: &token.Pos{Column: 14, Line: 3},
+			},
+			exptEnd: token.Pos{
+				Column: 14,
+				Line:   3,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -114,3 +301,66 @@ func TestNode_End(t *testing.T) {
 		})
 	}
 }
+
+func TestModifierList(t *testing.T) {
+	vs := &ast.Visibility{Type: token.Public, Value: "public", Position: token.Pos{Column: 1, Line: 1}}
+	mi := &ast.ModifierInvocation{
+		IdentifierPath: ast.IdentifierPath{
+			Elements: []*ast.IdentifierPathElement{
+				{Identifier: ast.Identifier{Type: token.Identifier, Value: "onlyOwner", Position: token.Pos{Column: 8, Line: 1}}},
+			},
+		},
+	}
+	ml := ast.ModifierList{
+		Elements: []ast.ModifierListElement{vs, mi},
+	}
+
+	if diff := cmp.Diff(vs, ml.Visibility()); diff != "" {
+		t.Error(diff)
+	}
+	if diff := cmp.Diff([]*ast.ModifierInvocation{mi}, ml.ModifierInvocations()); diff != "" {
+		t.Error(diff)
+	}
+	if ml.StateMutability() != nil || ml.Virtual() != nil || ml.OverrideSpecifier() != nil {
+		t.Error("unspecified modifiers are not nil")
+	}
+}
+
+func TestContractKind_String(t *testing.T) {
+	tests := []struct {
+		kind ast.ContractKind
+		want string
+	}{
+		{kind: ast.ContractKindContract, want: "contract"},
+		{kind: ast.ContractKindInterface, want: "interface"},
+		{kind: ast.ContractKindLibrary, want: "library"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCatchClauseKind_String(t *testing.T) {
+	tests := []struct {
+		kind ast.CatchClauseKind
+		want string
+	}{
+		{kind: ast.CatchClauseKindAll, want: "all"},
+		{kind: ast.CatchClauseKindError, want: "Error"},
+		{kind: ast.CatchClauseKindPanic, want: "Panic"},
+		{kind: ast.CatchClauseKindLowLevel, want: "low-level"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
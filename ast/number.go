@@ -0,0 +1,100 @@
+package ast
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var numberUnitMultipliers = map[string]int64{
+	"wei":     1,
+	"gwei":    1e9,
+	"ether":   1e18,
+	"seconds": 1,
+	"minutes": 60,
+	"hours":   60 * 60,
+	"days":    24 * 60 * 60,
+	"weeks":   7 * 24 * 60 * 60,
+	"years":   365 * 24 * 60 * 60,
+}
+
+// maxNumberExponent is the largest absolute exponent accepted in a DecimalNumber, as in solc.
+// It prevents a short literal such as 1e100000000 from building a huge big.Int.
+const maxNumberExponent = 4096
+
+// Multiplier returns the value that one unit represents, in wei or seconds.
+// It returns nil if n is not a known unit.
+func (n NumberUnit) Multiplier() *big.Int {
+	m, ok := numberUnitMultipliers[n.Value]
+	if !ok {
+		return nil
+	}
+	return big.NewInt(m)
+}
+
+// Rat returns the exact value of the number with the unit multiplier applied.
+// It returns nil if the number is malformed or its exponent is larger than maxNumberExponent.
+func (n NumberLiteral) Rat() *big.Rat {
+	v := parseNumber(n.Number.Value)
+	if v == nil {
+		return nil
+	}
+	if n.NumberUnit != nil {
+		m := n.NumberUnit.Multiplier()
+		if m == nil {
+			return nil
+		}
+		v.Mul(v, new(big.Rat).SetInt(m))
+	}
+	return v
+}
+
+// Int returns the exact value of the number with the unit multiplier applied.
+// ok is false if the value is not an integer (e.g. 1.5) or the number is malformed.
+func (n NumberLiteral) Int() (v *big.Int, ok bool) {
+	r := n.Rat()
+	if r == nil || !r.IsInt() {
+		return nil, false
+	}
+	return new(big.Int).Set(r.Num()), true
+}
+
+// parseNumber converts DecimalNumber or HexNumber to big.Rat.
+func parseNumber(str string) *big.Rat {
+	str = strings.ReplaceAll(str, "_", "")
+
+	if strings.HasPrefix(str, "0x") {
+		i, ok := new(big.Int).SetString(str[2:], 16)
+		if !ok {
+			return nil
+		}
+		return new(big.Rat).SetInt(i)
+	}
+
+	mantissa, exponent := str, 0
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		e, err := strconv.Atoi(str[i+1:])
+		if err != nil || e > maxNumberExponent || e < -maxNumberExponent {
+			return nil
+		}
+		mantissa, exponent = str[:i], e
+	}
+
+	digits := mantissa
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		digits = mantissa[:i] + mantissa[i+1:]
+		exponent -= len(mantissa) - i - 1
+	}
+
+	num, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return nil
+	}
+
+	if exponent >= 0 {
+		num.Mul(num, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil))
+		return new(big.Rat).SetInt(num)
+	}
+	den := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-exponent)), nil)
+	return new(big.Rat).SetFrac(num, den)
+}
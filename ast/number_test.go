@@ -0,0 +1,83 @@
+package ast_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestNumberLiteral_Rat(t *testing.T) {
+	tests := []struct {
+		number string
+		unit   string
+		want   string
+	}{
+		{number: "1_000", want: "1000"},
+		{number: "0xff", want: "255"},
+		{number: "0xdead_beef", want: "3735928559"},
+		{number: "2e10", want: "20000000000"},
+		{number: "1.5e3", want: "1500"},
+		{number: "1.25", want: "5/4"},
+		{number: ".5", want: "1/2"},
+		{number: "25e-2", want: "1/4"},
+		{number: "1.5", unit: "ether", want: "1500000000000000000"},
+		{number: "3", unit: "gwei", want: "3000000000"},
+		{number: "2", unit: "weeks", want: "1209600"},
+		{number: "1", unit: "years", want: "31536000"},
+		{number: "0.5", unit: "minutes", want: "30"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.number+" "+tt.unit, func(t *testing.T) {
+			n := ast.NumberLiteral{
+				Number: token.Token{Type: token.Number, Value: tt.number},
+			}
+			if tt.unit != "" {
+				n.NumberUnit = &ast.NumberUnit{Type: token.Identifier, Value: tt.unit}
+			}
+
+			want, _ := new(big.Rat).SetString(tt.want)
+			got := n.Rat()
+			if got == nil || got.Cmp(want) != 0 {
+				t.Errorf("got: %s, want: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestNumberLiteral_Int(t *testing.T) {
+	tests := []struct {
+		number string
+		want   string
+		ok     bool
+	}{
+		{number: "1e18", want: "1000000000000000000", ok: true},
+		{number: "0x1_00", want: "256", ok: true},
+		{number: "1.5", ok: false},
+		{number: "1e4096", want: "1" + strings.Repeat("0", 4096), ok: true},
+		{number: "1e4097", ok: false},
+		{number: "1e100000000", ok: false},
+		{number: "1e-100000000", ok: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.number, func(t *testing.T) {
+			n := ast.NumberLiteral{
+				Number: token.Token{Type: token.Number, Value: tt.number},
+			}
+
+			got, ok := n.Int()
+			if ok != tt.ok {
+				t.Fatalf("got ok: %t, want ok: %t", ok, tt.ok)
+			}
+			if ok && got.String() != tt.want {
+				t.Errorf("got: %s, want: %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package ast
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Bytes returns the import path without quotes, with escape sequences decoded.
+func (p Path) Bytes() []byte {
+	return decodeQuoted(p.Value)
+}
+
+// Bytes returns the value of the string literal with escape sequences decoded.
+func (s StringLiteral) Bytes() []byte {
+	return decodeQuoted(s.Value)
+}
+
+// Bytes returns the concatenated value of the string literals with escape sequences decoded.
+func (c ConcatenatedStringLiteral) Bytes() []byte {
+	var b []byte
+	for _, s := range c {
+		b = append(b, s.Bytes()...)
+	}
+	return b
+}
+
+// Bytes returns the UTF-8 encoded value of the unicode string literal with escape sequences decoded.
+func (u UnicordStrings) Bytes() []byte {
+	return decodeQuoted(strings.TrimPrefix(u.Value, "unicode"))
+}
+
+// Bytes returns the concatenated value of the unicode string literals with escape sequences decoded.
+func (u UnicordStringLiteral) Bytes() []byte {
+	var b []byte
+	for _, s := range u {
+		b = append(b, s.Bytes()...)
+	}
+	return b
+}
+
+// decodeQuoted removes the quotes of quoted and decodes escape sequences.
+// quoted is expected to be validated by the lexer.
+func decodeQuoted(quoted string) []byte {
+	if len(quoted) < 2 {
+		return nil
+	}
+	body := quoted[1 : len(quoted)-1]
+
+	b := make([]byte, 0, len(body))
+	for i := 0; i < len(body); i++ {
+		if body[i] != '\\' || i+1 == len(body) {
+			b = append(b, body[i])
+			continue
+		}
+
+		i++
+		switch c := body[i]; c {
+		case 'n':
+			b = append(b, '\n')
+		case 'r':
+			b = append(b, '\r')
+		case 't':
+			b = append(b, '\t')
+		case '\n':
+			// line continuation
+		case '\r':
+			// line continuation
+			if i+1 < len(body) && body[i+1] == '\n' {
+				i++
+			}
+		case 'x', 'u':
+			n := 2
+			if c == 'u' {
+				n = 4
+			}
+			if i+n >= len(body) {
+				return b
+			}
+			v, err := strconv.ParseUint(body[i+1:i+1+n], 16, 32)
+			if err != nil {
+				return b
+			}
+			if c == 'x' {
+				b = append(b, byte(v))
+			} else {
+				b = utf8.AppendRune(b, rune(v))
+			}
+			i += n
+		default:
+			b = append(b, c)
+		}
+	}
+	return b
+}
@@ -0,0 +1,68 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestStringLiteral_Bytes(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []byte
+	}{
+		{value: `"Hello"`, want: []byte("Hello")},
+		{value: `""`, want: []byte{}},
+		{value: `"a\nb\tc\rd"`, want: []byte("a\nb\tc\rd")},
+		{value: `"\"\\\'"`, want: []byte(`"\'`)},
+		{value: `"\x00\xff"`, want: []byte{0x00, 0xff}},
+		{value: `"é☺"`, want: []byte("é☺")},
+		{value: "\"a\\\nb\\\r\nc\"", want: []byte("abc")},
+		{value: `'it'`, want: []byte("it")},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.value, func(t *testing.T) {
+			s := ast.StringLiteral{
+				Type:  token.NonEmptyStringLiteral,
+				Value: tt.value,
+			}
+			if diff := cmp.Diff(tt.want, s.Bytes()); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestConcatenatedStringLiteral_Bytes(t *testing.T) {
+	c := ast.ConcatenatedStringLiteral{
+		{Type: token.NonEmptyStringLiteral, Value: `"a\x62"`},
+		{Type: token.EmptyStringLiteral, Value: `""`},
+		{Type: token.NonEmptyStringLiteral, Value: `"c"`},
+	}
+	if diff := cmp.Diff([]byte("abc"), c.Bytes()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestUnicordStringLiteral_Bytes(t *testing.T) {
+	u := ast.UnicordStringLiteral{
+		{Type: token.UnicodeStringLiteral, Value: `unicode"Hello 😃"`},
+		{Type: token.UnicodeStringLiteral, Value: `unicode"!"`},
+	}
+	if diff := cmp.Diff([]byte("Hello 😃!"), u.Bytes()); diff != "" {
+		t.Error(diff)
+	}
+}
+
+func TestPath_Bytes(t *testing.T) {
+	for _, v := range []string{`"a.sol"`, `'a.sol'`} {
+		p := ast.Path{Type: token.NonEmptyStringLiteral, Value: v}
+		if diff := cmp.Diff([]byte("a.sol"), p.Bytes()); diff != "" {
+			t.Error(diff)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+// Package yul declares the types used to represent syntax trees of Yul,
+// which is used in inline assembly of Solidity and in standalone Yul files.
+// Positions of the nodes are the same as the ones of the Solidity source.
+package yul
+
+import (
+	"github.com/uji/solparser/token"
+)
+
+// All node types implement the Node interface.
+type Node interface {
+	Pos() token.Pos
+	End() token.Pos
+}
+
+// All statement node types implement the Statement interface.
+type Statement interface {
+	Node
+	statementNode()
+}
+
+// All expression node types implement the Expression interface.
+type Expression interface {
+	Node
+	expressionNode()
+}
+
+// ----------------------------------------------------------------------------
+// Expression Nodes
+
+// Identifier including periods (e.g. x, x.slot, abi.decode)
+type Identifier token.Token
+
+func (i Identifier) Pos() token.Pos { return i.Position }
+func (i Identifier) End() token.Pos {
+	return token.Pos{
+		Column: i.Position.Column + len(i.Value),
+		Line:   i.Position.Line,
+	}
+}
+
+// Number, string, hex string or boolean literal.
+type Literal token.Token
+
+func (l Literal) Pos() token.Pos { return l.Position }
+func (l Literal) End() token.Pos {
+	return token.Pos{
+		Column: l.Position.Column + len([]rune(l.Value)),
+		Line:   l.Position.Line,
+	}
+}
+
+type Argument struct {
+	Expression Expression
+	Comma      *token.Pos
+}
+
+// Identifier ( Arguments ) (e.g. add(x, 1), sstore(0, v))
+// FunctionCall is also a statement when it is used as a statement.
+type FunctionCall struct {
+	Identifier Identifier
+	LParen     token.Pos
+	Arguments  []*Argument
+	RParen     token.Pos
+}
+
+func (f FunctionCall) Pos() token.Pos { return f.Identifier.Pos() }
+func (f FunctionCall) End() token.Pos { return f.RParen }
+
+func (*Identifier) expressionNode()   {}
+func (*Literal) expressionNode()      {}
+func (*FunctionCall) expressionNode() {}
+
+// ----------------------------------------------------------------------------
+// Statement Nodes
+
+type Block struct {
+	LBrace     token.Pos
+	Statements []Statement
+	RBrace     token.Pos
+}
+
+func (b Block) Pos() token.Pos { return b.LBrace }
+func (b Block) End() token.Pos { return b.RBrace }
+
+type IdentifierListElement struct {
+	Identifier Identifier
+	Comma      *token.Pos
+}
+
+// let Identifiers := Value
+type VariableDeclaration struct {
+	Let         token.Pos
+	Identifiers []*IdentifierListElement
+	Assign      *token.Pos
+	Value       Expression // nil if omitted
+}
+
+func (v VariableDeclaration) Pos() token.Pos { return v.Let }
+func (v VariableDeclaration) End() token.Pos {
+	if v.Value != nil {
+		return v.Value.End()
+	}
+	return v.Identifiers[len(v.Identifiers)-1].Identifier.End()
+}
+
+// Identifiers := Value
+type Assignment struct {
+	Identifiers []*IdentifierListElement
+	Assign      token.Pos
+	Value       Expression
+}
+
+func (a Assignment) Pos() token.Pos { return a.Identifiers[0].Identifier.Pos() }
+func (a Assignment) End() token.Pos { return a.Value.End() }
+
+// if Condition Body
+type If struct {
+	If        token.Pos
+	Condition Expression
+	Body      *Block
+}
+
+func (i If) Pos() token.Pos { return i.If }
+func (i If) End() token.Pos { return i.Body.End() }
+
+// case Value Body
+type Case struct {
+	Case  token.Pos
+	Value *Literal
+	Body  *Block
+}
+
+func (c Case) Pos() token.Pos { return c.Case }
+func (c Case) End() token.Pos { return c.Body.End() }
+
+// default Body
+type Default struct {
+	Default token.Pos
+	Body    *Block
+}
+
+func (d Default) Pos() token.Pos { return d.Default }
+func (d Default) End() token.Pos { return d.Body.End() }
+
+// switch Expression Cases Default
+type Switch struct {
+	Switch     token.Pos
+	Expression Expression
+	Cases      []*Case
+	Default    *Default // nil if omitted
+}
+
+func (s Switch) Pos() token.Pos { return s.Switch }
+func (s Switch) End() token.Pos {
+	if s.Default != nil {
+		return s.Default.End()
+	}
+	return s.Cases[len(s.Cases)-1].End()
+}
+
+// for Init Condition Post Body
+type For struct {
+	For       token.Pos
+	Init      *Block
+	Condition Expression
+	Post      *Block
+	Body      *Block
+}
+
+func (f For) Pos() token.Pos { return f.For }
+func (f For) End() token.Pos { return f.Body.End() }
+
+// function Name ( Parameters ) -> Returns Body
+type FunctionDefinition struct {
+	Function   token.Pos
+	Name       Identifier
+	LParen     token.Pos
+	Parameters []*IdentifierListElement
+	RParen     token.Pos
+	Arrow      *token.Pos
+	Returns    []*IdentifierListElement
+	Body       *Block
+}
+
+func (f FunctionDefinition) Pos() token.Pos { return f.Function }
+func (f FunctionDefinition) End() token.Pos { return f.Body.End() }
+
+type Leave struct {
+	Leave token.Pos
+}
+
+func (l Leave) Pos() token.Pos { return l.Leave }
+func (l Leave) End() token.Pos {
+	return token.Pos{Column: l.Leave.Column + len("leave"), Line: l.Leave.Line}
+}
+
+type Break struct {
+	Break token.Pos
+}
+
+func (b Break) Pos() token.Pos { return b.Break }
+func (b Break) End() token.Pos {
+	return token.Pos{Column: b.Break.Column + len("break"), Line: b.Break.Line}
+}
+
+type Continue struct {
+	Continue token.Pos
+}
+
+func (c Continue) Pos() token.Pos { return c.Continue }
+func (c Continue) End() token.Pos {
+	return token.Pos{Column: c.Continue.Column + len("continue"), Line: c.Continue.Line}
+}
+
+func (*Block) statementNode()               {}
+func (*VariableDeclaration) statementNode() {}
+func (*Assignment) statementNode()          {}
+func (*If) statementNode()                  {}
+func (*Switch) statementNode()              {}
+func (*For) statementNode()                 {}
+func (*FunctionDefinition) statementNode()  {}
+func (*Leave) statementNode()               {}
+func (*Break) statementNode()               {}
+func (*Continue) statementNode()            {}
+func (*FunctionCall) statementNode()        {}
+
+// ----------------------------------------------------------------------------
+// Object Nodes
+
+// All object-element node types implement the ObjectElement interface.
+type ObjectElement interface {
+	Node
+	objectElementNode()
+}
+
+// code Block
+type Code struct {
+	Code  token.Pos
+	Block *Block
+}
+
+func (c Code) Pos() token.Pos { return c.Code }
+func (c Code) End() token.Pos { return c.Block.End() }
+
+// object Name { Code Elements } (e.g. object "Token" { code { } object "Token_deployed" { code { } } })
+type Object struct {
+	Object   token.Pos
+	Name     *Literal
+	LBrace   token.Pos
+	Code     *Code
+	Elements []ObjectElement
+	RBrace   token.Pos
+}
+
+func (o Object) Pos() token.Pos { return o.Object }
+func (o Object) End() token.Pos { return o.RBrace }
+
+// data Name Value (e.g. data "Table" hex"4123")
+type Data struct {
+	Data  token.Pos
+	Name  *Literal
+	Value *Literal // string literal or hex string
+}
+
+func (d Data) Pos() token.Pos { return d.Data }
+func (d Data) End() token.Pos { return d.Value.End() }
+
+func (*Object) objectElementNode() {}
+func (*Data) objectElementNode()   {}
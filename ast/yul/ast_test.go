@@ -0,0 +1,86 @@
+package yul_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/uji/solparser/ast/yul"
+	"github.com/uji/solparser/token"
+)
+
+var (
+	_ yul.Expression    = &yul.Identifier{}
+	_ yul.Expression    = &yul.Literal{}
+	_ yul.Expression    = &yul.FunctionCall{}
+	_ yul.Statement     = &yul.FunctionCall{}
+	_ yul.Statement     = &yul.Block{}
+	_ yul.Statement     = &yul.VariableDeclaration{}
+	_ yul.Statement     = &yul.Assignment{}
+	_ yul.Statement     = &yul.If{}
+	_ yul.Statement     = &yul.Switch{}
+	_ yul.Statement     = &yul.For{}
+	_ yul.Statement     = &yul.FunctionDefinition{}
+	_ yul.Statement     = &yul.Leave{}
+	_ yul.Statement     = &yul.Break{}
+	_ yul.Statement     = &yul.Continue{}
+	_ yul.Node          = &yul.Case{}
+	_ yul.Node          = &yul.Default{}
+	_ yul.Node          = &yul.Code{}
+	_ yul.ObjectElement = &yul.Object{}
+	_ yul.ObjectElement = &yul.Data{}
+)
+
+func TestNode_End(t *testing.T) {
+	tests := []struct {
+		name    string
+		node    yul.Node
+		exptEnd token.Pos
+	}{
+		{
+			name: "Identifier including periods",
+			node: &yul.Identifier{
+				Type:     token.Identifier,
+				Value:    "x.slot",
+				Position: token.Pos{Column: 4, Line: 3},
+			},
+			exptEnd: token.Pos{Column: 10, Line: 3},
+		},
+		{
+			name: "VariableDeclaration without value",
+			node: &yul.VariableDeclaration{
+				Let: token.Pos{Column: 1, Line: 3},
+				Identifiers: []*yul.IdentifierListElement{
+					{
+						Identifier: yul.Identifier{
+							Type:     token.Identifier,
+							Value:    "a",
+							Position: token.Pos{Column: 5, Line: 3},
+						},
+						Comma: &token.Pos{Column: 6, Line: 3},
+					},
+					{
+						Identifier: yul.Identifier{
+							Type:     token.Identifier,
+							Value:    "bc",
+							Position: token.Pos{Column: 8, Line: 3},
+						},
+					},
+				},
+			},
+			exptEnd: token.Pos{Column: 10, Line: 3},
+		},
+		{
+			name:    "Leave",
+			node:    &yul.Leave{Leave: token.Pos{Column: 4, Line: 3}},
+			exptEnd: token.Pos{Column: 9, Line: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.node.End(), tt.exptEnd); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -15,9 +15,21 @@ func (p *Parser) ParseBlock() (*ast.Block, error) {
 		return nil, token.NewPosError(lblace.Position, "not found LBrace.")
 	}
 
-	stmt, err := p.ParseStatement()
-	if err != nil {
-		return nil, err
+	var stmts []ast.Statement
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type == token.RBrace || tkn.Type == token.EOS {
+			break
+		}
+
+		stmt, err := p.ParseStatement()
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
 	}
 
 	rblace, err := p.lexer.Scan()
@@ -32,6 +44,27 @@ func (p *Parser) ParseBlock() (*ast.Block, error) {
 	return &ast.Block{
 		LBracePos: lblace.Position,
 		RBracePos: rblace.Position,
-		Nodes:     []ast.Node{stmt},
+		Nodes:     stmts,
+	}, nil
+}
+
+func (p *Parser) ParseUncheckedBlock() (*ast.UncheckedBlock, error) {
+	unchecked, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+
+	if unchecked.Type != token.Unchecked {
+		return nil, token.NewPosError(unchecked.Position, "not found unchecked keyword.")
+	}
+
+	b, err := p.ParseBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.UncheckedBlock{
+		Unchecked: unchecked.Position,
+		Block:     b,
 	}, nil
 }
@@ -24,7 +24,7 @@ func TestParser_ParseBlock(t *testing.T) {
 			want: &ast.Block{
 				LBracePos: token.Pos{Column: 1, Line: 1},
 				RBracePos: token.Pos{Column: 2, Line: 3},
-				Nodes: []ast.Node{
+				Nodes: []ast.Statement{
 					&ast.ReturnStatement{
 						From:    token.Pos{Column: 1, Line: 2},
 						SemiPos: token.Pos{Column: 23, Line: 2},
@@ -38,6 +38,65 @@ func TestParser_ParseBlock(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name:  "Empty",
+			input: "{}",
+			want: &ast.Block{
+				LBracePos: token.Pos{Column: 1, Line: 1},
+				RBracePos: token.Pos{Column: 2, Line: 1},
+			},
+			err: nil,
+		},
+		{
+			name:  "Statements and nested block",
+			input: "{\n  a;\n  { b; }\n  unchecked { c; }\n}",
+			want: &ast.Block{
+				LBracePos: token.Pos{Column: 1, Line: 1},
+				RBracePos: token.Pos{Column: 1, Line: 5},
+				Nodes: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.Identifier{
+							Type:     token.Identifier,
+							Value:    "a",
+							Position: token.Pos{Column: 3, Line: 2},
+						},
+						Semicolon: token.Pos{Column: 4, Line: 2},
+					},
+					&ast.Block{
+						LBracePos: token.Pos{Column: 3, Line: 3},
+						RBracePos: token.Pos{Column: 8, Line: 3},
+						Nodes: []ast.Statement{
+							&ast.ExpressionStatement{
+								Expression: &ast.Identifier{
+									Type:     token.Identifier,
+									Value:    "b",
+									Position: token.Pos{Column: 5, Line: 3},
+								},
+								Semicolon: token.Pos{Column: 6, Line: 3},
+							},
+						},
+					},
+					&ast.UncheckedBlock{
+						Unchecked: token.Pos{Column: 3, Line: 4},
+						Block: &ast.Block{
+							LBracePos: token.Pos{Column: 13, Line: 4},
+							RBracePos: token.Pos{Column: 18, Line: 4},
+							Nodes: []ast.Statement{
+								&ast.ExpressionStatement{
+									Expression: &ast.Identifier{
+										Type:     token.Identifier,
+										Value:    "c",
+										Position: token.Pos{Column: 15, Line: 4},
+									},
+									Semicolon: token.Pos{Column: 16, Line: 4},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
 		{
 			name:  "Not found LBrace",
 			input: "pragma",
@@ -10,6 +10,9 @@ func (p *Parser) ParseCallArgumentList() (*ast.CallArgumentList, error) {
 	if err != nil {
 		return nil, err
 	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
 
 	tkn, err := p.lexer.Peek()
 	if err != nil {
@@ -25,17 +28,17 @@ func (p *Parser) ParseCallArgumentList() (*ast.CallArgumentList, error) {
 
 	var elements ast.CallArgumentListElements
 	if tkn.Type == token.LBrace {
-		es, err := p.ParseCallArgumentListExpretions()
+		nes, err := p.ParseCallArgumentListNamedExpretions()
 		if err != nil {
 			return nil, err
 		}
-		elements = es
+		elements = nes
 	} else {
-		nes, err := p.ParseCallArgumentListNamedExpretions()
+		es, err := p.ParseCallArgumentListExpretions()
 		if err != nil {
 			return nil, err
 		}
-		elements = nes
+		elements = es
 	}
 
 	rparen, err := p.lexer.Scan()
@@ -73,6 +76,9 @@ func (p *Parser) ParseCallArgumentListExpretions() (ast.CallArgumentListExpretio
 		})
 
 		e, err := p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
 		ex = e
 	}
 
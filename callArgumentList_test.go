@@ -0,0 +1,72 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseCallArgumentList(t *testing.T) {
+	tests := TestData[*ast.CallArgumentList]{
+		{
+			input: "()",
+			want: &ast.CallArgumentList{
+				LParen: pos(1, 1),
+				RParen: pos(2, 1),
+			},
+		},
+		{
+			input: "(a, b)",
+			want: &ast.CallArgumentList{
+				LParen: pos(1, 1),
+				Elements: ast.CallArgumentListExpretions{
+					{Expression: identPtr("a", pos(2, 1)), Comma: posPtr(3, 1)},
+					{Expression: identPtr("b", pos(5, 1))},
+				},
+				RParen: pos(6, 1),
+			},
+		},
+		{
+			input: "({x: a, y: b})",
+			want: &ast.CallArgumentList{
+				LParen: pos(1, 1),
+				Elements: &ast.CallArgumentListNamedExpretions{
+					LBrace: pos(2, 1),
+					NamedExpretions: []*ast.CallArgumentListNamedExpretion{
+						{
+							Identifier: ast.Identifier(tkn(token.Identifier, "x", pos(3, 1))),
+							Colon:      pos(4, 1),
+							Expression: identPtr("a", pos(6, 1)),
+							Comma:      posPtr(7, 1),
+						},
+						{
+							Identifier: ast.Identifier(tkn(token.Identifier, "y", pos(9, 1))),
+							Colon:      pos(10, 1),
+							Expression: identPtr("b", pos(12, 1)),
+						},
+					},
+					RBrace: pos(13, 1),
+				},
+				RParen: pos(14, 1),
+			},
+		},
+		{
+			input: "a)",
+			err:   perr(pos(1, 1), "not found LParen."),
+		},
+		{
+			input: "(a, )",
+			err:   perr(pos(5, 1), "not found expression."),
+		},
+		{
+			input: "(a;",
+			err:   perr(pos(3, 1), "not found RParen."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.CallArgumentList, error) {
+		return p.ParseCallArgumentList()
+	})
+}
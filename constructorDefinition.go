@@ -0,0 +1,66 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+// checkModifierList returns an error at the first element of ml which is not allowed.
+func checkModifierList(ml *ast.ModifierList, allowed func(ast.ModifierListElement) bool, msg string) error {
+	for _, e := range ml.Elements {
+		if !allowed(e) {
+			return token.NewPosError(e.Pos(), msg)
+		}
+	}
+	return nil
+}
+
+// constructor modifiers are modifier invocations, payable, internal and public.
+func isConstructorModifier(e ast.ModifierListElement) bool {
+	switch e := e.(type) {
+	case *ast.ModifierInvocation:
+		return true
+	case *ast.Visibility:
+		return e.Type == token.Internal || e.Type == token.Public
+	case *ast.StateMutability:
+		return e.Type == token.Payable
+	}
+	return false
+}
+
+func (p *Parser) ParseConstructorDefinition() (*ast.ConstructorDefinition, error) {
+	cnst, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if cnst.Type != token.Constructor {
+		return nil, token.NewPosError(cnst.Position, "not found constructor keyword.")
+	}
+
+	lparen, prms, rparen, err := p.parseParameters()
+	if err != nil {
+		return nil, err
+	}
+
+	ml, err := p.ParseModifierList()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModifierList(ml, isConstructorModifier, "invalid constructor modifier."); err != nil {
+		return nil, err
+	}
+
+	b, err := p.ParseBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.ConstructorDefinition{
+		Constructor:  cnst.Position,
+		LParen:       lparen,
+		Parameters:   prms,
+		RParen:       rparen,
+		ModifierList: ml,
+		Block:        b,
+	}, nil
+}
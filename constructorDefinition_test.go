@@ -0,0 +1,88 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseConstructorDefinition(t *testing.T) {
+	tests := TestData[*ast.ConstructorDefinition]{
+		{
+			input: "constructor(bool b) payable Base(1) {}",
+			want: &ast.ConstructorDefinition{
+				Constructor: pos(1, 1),
+				LParen:      pos(12, 1),
+				Parameters: ast.ParameterList{
+					{
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(13, 1)), Kind: ast.ElementaryTypeNameKindBool},
+						Identifier: identPtr("b", pos(18, 1)),
+					},
+				},
+				RParen: pos(19, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.StateMutability{Type: token.Payable, Value: "payable", Position: pos(21, 1)},
+						&ast.ModifierInvocation{
+							IdentifierPath: ast.IdentifierPath{
+								Elements: []*ast.IdentifierPathElement{
+									{Identifier: *identPtr("Base", pos(29, 1))},
+								},
+							},
+							CallArgumentList: &ast.CallArgumentList{
+								LParen: pos(33, 1),
+								Elements: ast.CallArgumentListExpretions{
+									{Expression: &ast.NumberLiteral{Number: tkn(token.Number, "1", pos(34, 1))}},
+								},
+								RParen: pos(35, 1),
+							},
+						},
+					},
+				},
+				Block: &ast.Block{
+					LBracePos: pos(37, 1),
+					RBracePos: pos(38, 1),
+				},
+			},
+		},
+		{
+			input: "constructor() internal {}",
+			want: &ast.ConstructorDefinition{
+				Constructor: pos(1, 1),
+				LParen:      pos(12, 1),
+				RParen:      pos(13, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{Type: token.Internal, Value: "internal", Position: pos(15, 1)},
+					},
+				},
+				Block: &ast.Block{
+					LBracePos: pos(24, 1),
+					RBracePos: pos(25, 1),
+				},
+			},
+		},
+		{
+			input: "function() {}",
+			err:   perr(pos(1, 1), "not found constructor keyword."),
+		},
+		{
+			input: "constructor() external {}",
+			err:   perr(pos(15, 1), "invalid constructor modifier."),
+		},
+		{
+			input: "constructor() virtual {}",
+			err:   perr(pos(15, 1), "invalid constructor modifier."),
+		},
+		{
+			input: "constructor();",
+			err:   perr(pos(14, 1), "not found LBrace."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.ConstructorDefinition, error) {
+		return p.ParseConstructorDefinition()
+	})
+}
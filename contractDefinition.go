@@ -5,6 +5,68 @@ import (
 	"github.com/uji/solparser/token"
 )
 
+func (p *Parser) parseContractBodyElement() (ast.ContractBodyElement, error) {
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	isErr, err := p.isErrorDefinition()
+	if err != nil {
+		return nil, err
+	}
+	if isErr {
+		ed, err := p.ParseErrorDefinition()
+		if err != nil {
+			return nil, err
+		}
+		return ed, nil
+	}
+
+	var elm ast.ContractBodyElement
+	switch tkn.Type {
+	case token.Function:
+		// function ( is a function type name of a state variable. (e.g. function (uint) external returns (bool) fp;)
+		var lparen token.Token
+		lparen, err = p.lexer.PeekN(2)
+		if err != nil {
+			return nil, err
+		}
+		if lparen.Type == token.LParen {
+			elm, err = p.ParseStateVariableDeclaration()
+			break
+		}
+		elm, err = p.ParseFunctionDefinition()
+	case token.Constructor:
+		elm, err = p.ParseConstructorDefinition()
+	case token.Fallback:
+		elm, err = p.ParseFallbackFunctionDefinition()
+	case token.Receive:
+		elm, err = p.ParseReceiveFunctionDefinition()
+	case token.Modifier:
+		elm, err = p.ParseModifierDefinition()
+	case token.Struct:
+		elm, err = p.ParseStructDefinition()
+	case token.Enum:
+		elm, err = p.ParseEnumDefinition()
+	case token.Type:
+		elm, err = p.ParseUserDefinedValueTypeDefinition()
+	case token.Event:
+		elm, err = p.ParseEventDefinition()
+	case token.Using:
+		elm, err = p.ParseUsingDirective()
+	default:
+		if !isTypeNameStart(tkn) {
+			return nil, token.NewPosError(tkn.Position, "not found contract body element.")
+		}
+		elm, err = p.ParseStateVariableDeclaration()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return elm, nil
+}
+
 func (p *Parser) ParseContractDefinition() (*ast.ContractDefinition, error) {
 	cntr, err := p.lexer.Scan()
 	if err != nil {
@@ -19,7 +81,16 @@ func (p *Parser) ParseContractDefinition() (*ast.ContractDefinition, error) {
 			return nil, err
 		}
 	}
-	if cntr.Type != token.Contract {
+
+	var kind ast.ContractKind
+	switch {
+	case cntr.Type == token.Contract:
+		kind = ast.ContractKindContract
+	case cntr.Type == token.Interface && abstractPos == nil:
+		kind = ast.ContractKindInterface
+	case cntr.Type == token.Library && abstractPos == nil:
+		kind = ast.ContractKindLibrary
+	default:
 		return nil, token.NewPosError(cntr.Position, "not found contract keyword.")
 	}
 
@@ -28,6 +99,36 @@ func (p *Parser) ParseContractDefinition() (*ast.ContractDefinition, error) {
 		return nil, err
 	}
 
+	is, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var isPos *token.Pos
+	var inhs []*ast.InheritanceSpecifier
+	if is.Type == token.Is {
+		p.lexer.Scan()
+		isPos = &is.Position
+
+		for {
+			inh, err := p.ParseInheritanceSpecifire()
+			if err != nil {
+				return nil, err
+			}
+			inhs = append(inhs, inh)
+
+			cmm, err := p.lexer.Peek()
+			if err != nil {
+				return nil, err
+			}
+			if cmm.Type != token.Comma {
+				break
+			}
+			p.lexer.Scan()
+			inh.Comma = &cmm.Position
+		}
+	}
+
 	lbrace, err := p.lexer.Scan()
 	if err != nil {
 		return nil, err
@@ -36,9 +137,21 @@ func (p *Parser) ParseContractDefinition() (*ast.ContractDefinition, error) {
 		return nil, token.NewPosError(lbrace.Position, "not found left brace.")
 	}
 
-	fn, err := p.ParseFunctionDefinition()
-	if err != nil {
-		return nil, err
+	var elms []ast.ContractBodyElement
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type == token.RBrace || tkn.Type == token.EOS {
+			break
+		}
+
+		elm, err := p.parseContractBodyElement()
+		if err != nil {
+			return nil, err
+		}
+		elms = append(elms, elm)
 	}
 
 	rbrace, err := p.lexer.Scan()
@@ -50,11 +163,14 @@ func (p *Parser) ParseContractDefinition() (*ast.ContractDefinition, error) {
 	}
 
 	return &ast.ContractDefinition{
-		Abstract:             abstractPos,
-		Contract:             cntr.Position,
-		Identifier:           i,
-		LBrace:               lbrace.Position,
-		ContractBodyElements: []ast.ContractBodyElement{fn},
-		RBrace:               rbrace.Position,
+		Abstract:              abstractPos,
+		Kind:                  kind,
+		Contract:              cntr.Position,
+		Identifier:            i,
+		Is:                    isPos,
+		InheritanceSpecifiers: inhs,
+		LBrace:                lbrace.Position,
+		ContractBodyElements:  elms,
+		RBrace:                rbrace.Position,
 	}, nil
 }
@@ -44,15 +44,17 @@ func TestParser_ParseContractDefinition(t *testing.T) {
 						LParen: token.Pos{Column: 19, Line: 2},
 						RParen: token.Pos{Column: 20, Line: 2},
 						ModifierList: &ast.ModifierList{
-							Visibility: &token.Token{
-								Type:     token.Public,
-								Value:    "public",
-								Position: token.Pos{Column: 22, Line: 2},
-							},
-							StateMutability: &token.Token{
-								Type:     token.Pure,
-								Value:    "pure",
-								Position: token.Pos{Column: 29, Line: 2},
+							Elements: []ast.ModifierListElement{
+								&ast.Visibility{
+									Type:     token.Public,
+									Value:    "public",
+									Position: token.Pos{Column: 22, Line: 2},
+								},
+								&ast.StateMutability{
+									Type:     token.Pure,
+									Value:    "pure",
+									Position: token.Pos{Column: 29, Line: 2},
+								},
 							},
 						},
 						Returns: &ast.FunctionDefinitionReturns{
@@ -61,11 +63,12 @@ func TestParser_ParseContractDefinition(t *testing.T) {
 							ParameterList: []*ast.Parameter{
 								{
 									TypeName: ast.ElementaryTypeName{
-										{
+										Token: token.Token{
 											Type:     token.String,
 											Value:    "string",
 											Position: token.Pos{Column: 43, Line: 2},
 										},
+										Kind: ast.ElementaryTypeNameKindString,
 									},
 								},
 							},
@@ -74,7 +77,7 @@ func TestParser_ParseContractDefinition(t *testing.T) {
 						Block: &ast.Block{
 							LBracePos: token.Pos{Column: 51, Line: 2},
 							RBracePos: token.Pos{Column: 5, Line: 4},
-							Nodes: []ast.Node{
+							Nodes: []ast.Statement{
 								&ast.ReturnStatement{
 									From:    token.Pos{Column: 9, Line: 3},
 									SemiPos: token.Pos{Column: 31, Line: 3},
@@ -118,15 +121,17 @@ func TestParser_ParseContractDefinition(t *testing.T) {
 						LParen: token.Pos{Column: 19, Line: 2},
 						RParen: token.Pos{Column: 20, Line: 2},
 						ModifierList: &ast.ModifierList{
-							Visibility: &token.Token{
-								Type:     token.Public,
-								Value:    "public",
-								Position: token.Pos{Column: 22, Line: 2},
-							},
-							StateMutability: &token.Token{
-								Type:     token.Pure,
-								Value:    "pure",
-								Position: token.Pos{Column: 29, Line: 2},
+							Elements: []ast.ModifierListElement{
+								&ast.Visibility{
+									Type:     token.Public,
+									Value:    "public",
+									Position: token.Pos{Column: 22, Line: 2},
+								},
+								&ast.StateMutability{
+									Type:     token.Pure,
+									Value:    "pure",
+									Position: token.Pos{Column: 29, Line: 2},
+								},
 							},
 						},
 						Returns: &ast.FunctionDefinitionReturns{
@@ -135,11 +140,12 @@ func TestParser_ParseContractDefinition(t *testing.T) {
 							ParameterList: []*ast.Parameter{
 								{
 									TypeName: ast.ElementaryTypeName{
-										{
+										Token: token.Token{
 											Type:     token.String,
 											Value:    "string",
 											Position: token.Pos{Column: 43, Line: 2},
 										},
+										Kind: ast.ElementaryTypeNameKindString,
 									},
 								},
 							},
@@ -148,7 +154,7 @@ func TestParser_ParseContractDefinition(t *testing.T) {
 						Block: &ast.Block{
 							LBracePos: token.Pos{Column: 51, Line: 2},
 							RBracePos: token.Pos{Column: 5, Line: 4},
-							Nodes: []ast.Node{
+							Nodes: []ast.Statement{
 								&ast.ReturnStatement{
 									From:    token.Pos{Column: 9, Line: 3},
 									SemiPos: token.Pos{Column: 31, Line: 3},
@@ -198,11 +204,11 @@ func TestParser_ParseContractDefinition(t *testing.T) {
 			},
 		},
 		{
-			name:  "not found FunctionDefinition",
-			input: "contract HelloWorld { hello() }",
+			name:  "not found ContractBodyElement",
+			input: "contract HelloWorld { ; }",
 			err: &token.PosError{
 				Pos: token.Pos{Column: 23, Line: 1},
-				Msg: "not found function keyword.",
+				Msg: "not found contract body element.",
 			},
 		},
 		{
@@ -238,3 +244,359 @@ func TestParser_ParseContractDefinition(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_ParseContractDefinition_BodyElements(t *testing.T) {
+	tests := TestData[*ast.ContractDefinition]{
+		{
+			input: "contract A { modifier m() { _; } }",
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("A", pos(10, 1)),
+				LBrace:     pos(12, 1),
+				ContractBodyElements: []ast.ContractBodyElement{
+					&ast.ModifierDefinition{
+						Modifier:     pos(14, 1),
+						Identifier:   *identPtr("m", pos(23, 1)),
+						LParen:       posPtr(24, 1),
+						RParen:       posPtr(25, 1),
+						ModifierList: &ast.ModifierList{},
+						Block: &ast.Block{
+							LBracePos: pos(27, 1),
+							Nodes: []ast.Statement{
+								&ast.PlaceholderStatement{
+									Placeholder: pos(29, 1),
+									Semicolon:   pos(30, 1),
+								},
+							},
+							RBracePos: pos(32, 1),
+						},
+					},
+				},
+				RBrace: pos(34, 1),
+			},
+		},
+		{
+			input: "contract A { receive() external payable {} }",
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("A", pos(10, 1)),
+				LBrace:     pos(12, 1),
+				ContractBodyElements: []ast.ContractBodyElement{
+					&ast.ReceiveFunctionDefinition{
+						Receive: pos(14, 1),
+						LParen:  pos(21, 1),
+						RParen:  pos(22, 1),
+						ModifierList: &ast.ModifierList{
+							Elements: []ast.ModifierListElement{
+								&ast.Visibility{Type: token.External, Value: "external", Position: pos(24, 1)},
+								&ast.StateMutability{Type: token.Payable, Value: "payable", Position: pos(33, 1)},
+							},
+						},
+						Block: &ast.Block{
+							LBracePos: pos(41, 1),
+							RBracePos: pos(42, 1),
+						},
+					},
+				},
+				RBrace: pos(44, 1),
+			},
+		},
+		{
+			input: `contract A {
+    address immutable owner;
+    constructor() {}
+}`,
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("A", pos(10, 1)),
+				LBrace:     pos(12, 1),
+				ContractBodyElements: []ast.ContractBodyElement{
+					&ast.StateVariableDeclaration{
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(5, 2)), Kind: ast.ElementaryTypeNameKindAddress},
+						Immutable:  posPtr(13, 2),
+						Identifier: *identPtr("owner", pos(23, 2)),
+						Semicolon:  pos(28, 2),
+					},
+					&ast.ConstructorDefinition{
+						Constructor:  pos(5, 3),
+						LParen:       pos(16, 3),
+						RParen:       pos(17, 3),
+						ModifierList: &ast.ModifierList{},
+						Block: &ast.Block{
+							LBracePos: pos(19, 3),
+							RBracePos: pos(20, 3),
+						},
+					},
+				},
+				RBrace: pos(1, 4),
+			},
+		},
+		{
+			input: "contract A { enum E { X } type T is bool; }",
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("A", pos(10, 1)),
+				LBrace:     pos(12, 1),
+				ContractBodyElements: []ast.ContractBodyElement{
+					&ast.EnumDefinition{
+						Enum:       pos(14, 1),
+						Identifier: *identPtr("E", pos(19, 1)),
+						LBrace:     pos(21, 1),
+						Values: []*ast.EnumValue{
+							{Identifier: *identPtr("X", pos(23, 1))},
+						},
+						RBrace: pos(25, 1),
+					},
+					&ast.UserDefinedValueTypeDefinition{
+						Type:       pos(27, 1),
+						Identifier: *identPtr("T", pos(32, 1)),
+						Is:         pos(34, 1),
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(37, 1)), Kind: ast.ElementaryTypeNameKindBool},
+						Semicolon:  pos(41, 1),
+					},
+				},
+				RBrace: pos(43, 1),
+			},
+		},
+		{
+			input: "contract A { event E(); error X(); }",
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("A", pos(10, 1)),
+				LBrace:     pos(12, 1),
+				ContractBodyElements: []ast.ContractBodyElement{
+					&ast.EventDefinition{
+						Event:      pos(14, 1),
+						Identifier: *identPtr("E", pos(20, 1)),
+						LParen:     pos(21, 1),
+						RParen:     pos(22, 1),
+						Semicolon:  pos(23, 1),
+					},
+					&ast.ErrorDefinition{
+						Error:      pos(25, 1),
+						Identifier: *identPtr("X", pos(31, 1)),
+						LParen:     pos(32, 1),
+						RParen:     pos(33, 1),
+						Semicolon:  pos(34, 1),
+					},
+				},
+				RBrace: pos(36, 1),
+			},
+		},
+		{
+			input: "contract A { using L for *; }",
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("A", pos(10, 1)),
+				LBrace:     pos(12, 1),
+				ContractBodyElements: []ast.ContractBodyElement{
+					&ast.UsingDirective{
+						Using: pos(14, 1),
+						IdentifierPath: &ast.IdentifierPath{
+							Elements: []*ast.IdentifierPathElement{
+								{Identifier: *identPtr("L", pos(20, 1))},
+							},
+						},
+						For:       pos(22, 1),
+						Mul:       posPtr(26, 1),
+						Semicolon: pos(27, 1),
+					},
+				},
+				RBrace: pos(29, 1),
+			},
+		},
+		{
+			input: "contract A { function (uint) external view returns (bool) fp; }",
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("A", pos(10, 1)),
+				LBrace:     pos(12, 1),
+				ContractBodyElements: []ast.ContractBodyElement{
+					&ast.StateVariableDeclaration{
+						TypeName: &ast.FunctionTypeName{
+							Function: pos(14, 1),
+							LParen:   pos(23, 1),
+							Parameters: ast.ParameterList{
+								{TypeName: ast.ElementaryTypeName{Token: tkn(token.Identifier, "uint", pos(24, 1)), Kind: ast.ElementaryTypeNameKindUint, Bits: 256}},
+							},
+							RParen: pos(28, 1),
+							ModifierList: &ast.ModifierList{
+								Elements: []ast.ModifierListElement{
+									&ast.Visibility{Type: token.External, Value: "external", Position: pos(30, 1)},
+									&ast.StateMutability{Type: token.View, Value: "view", Position: pos(39, 1)},
+								},
+							},
+							Returns: &ast.FunctionDefinitionReturns{
+								From:   pos(44, 1),
+								LParen: pos(52, 1),
+								ParameterList: ast.ParameterList{
+									{TypeName: ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(53, 1)), Kind: ast.ElementaryTypeNameKindBool}},
+								},
+								RParen: pos(57, 1),
+							},
+						},
+						Identifier: *identPtr("fp", pos(59, 1)),
+						Semicolon:  pos(61, 1),
+					},
+				},
+				RBrace: pos(63, 1),
+			},
+		},
+		{
+			input: "contract A {}",
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("A", pos(10, 1)),
+				LBrace:     pos(12, 1),
+				RBrace:     pos(13, 1),
+			},
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.ContractDefinition, error) {
+		return p.ParseContractDefinition()
+	})
+}
+
+func TestParser_ParseContractDefinition_Kinds(t *testing.T) {
+	tests := TestData[*ast.ContractDefinition]{
+		{
+			input: "interface I { function f() external; }",
+			want: &ast.ContractDefinition{
+				Kind:       ast.ContractKindInterface,
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("I", pos(11, 1)),
+				LBrace:     pos(13, 1),
+				ContractBodyElements: []ast.ContractBodyElement{
+					&ast.FunctionDefinition{
+						From:               pos(15, 1),
+						FunctionDescriptor: tkn(token.Identifier, "f", pos(24, 1)),
+						LParen:             pos(25, 1),
+						RParen:             pos(26, 1),
+						ModifierList: &ast.ModifierList{
+							Elements: []ast.ModifierListElement{
+								&ast.Visibility{Type: token.External, Value: "external", Position: pos(28, 1)},
+							},
+						},
+						Semicolon: posPtr(36, 1),
+					},
+				},
+				RBrace: pos(38, 1),
+			},
+		},
+		{
+			input: "library L {}",
+			want: &ast.ContractDefinition{
+				Kind:       ast.ContractKindLibrary,
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("L", pos(9, 1)),
+				LBrace:     pos(11, 1),
+				RBrace:     pos(12, 1),
+			},
+		},
+		{
+			input: "abstract contract C {}",
+			want: &ast.ContractDefinition{
+				Abstract:   posPtr(1, 1),
+				Kind:       ast.ContractKindContract,
+				Contract:   pos(10, 1),
+				Identifier: *identPtr("C", pos(19, 1)),
+				LBrace:     pos(21, 1),
+				RBrace:     pos(22, 1),
+			},
+		},
+		{
+			input: `contract Token is ERC20("T"), Ownable {}`,
+			want: &ast.ContractDefinition{
+				Contract:   pos(1, 1),
+				Identifier: *identPtr("Token", pos(10, 1)),
+				Is:         posPtr(16, 1),
+				InheritanceSpecifiers: []*ast.InheritanceSpecifier{
+					{
+						IdentifierPath: ast.IdentifierPath{
+							Elements: []*ast.IdentifierPathElement{
+								{Identifier: *identPtr("ERC20", pos(19, 1))},
+							},
+						},
+						CallArgumentList: &ast.CallArgumentList{
+							LParen: pos(24, 1),
+							Elements: ast.CallArgumentListExpretions{
+								{
+									Expression: &ast.StringLiteral{
+										Type:     token.NonEmptyStringLiteral,
+										Value:    `"T"`,
+										Position: pos(25, 1),
+									},
+								},
+							},
+							RParen: pos(28, 1),
+						},
+						Comma: posPtr(29, 1),
+					},
+					{
+						IdentifierPath: ast.IdentifierPath{
+							Elements: []*ast.IdentifierPathElement{
+								{Identifier: *identPtr("Ownable", pos(31, 1))},
+							},
+						},
+					},
+				},
+				LBrace: pos(39, 1),
+				RBrace: pos(40, 1),
+			},
+		},
+		{
+			input: "contract A is {}",
+			err:   perr(pos(15, 1), "keyword is not available as identifier."),
+		},
+		{
+			input: "abstract interface I {}",
+			err:   perr(pos(10, 1), "not found contract keyword."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.ContractDefinition, error) {
+		return p.ParseContractDefinition()
+	})
+}
+
+func TestParser_ParseContractDefinition_FunctionTypeStateVariable(t *testing.T) {
+	p := solparser.New(strings.NewReader("contract A { function (uint) external payable returns (bool) public fp; }"))
+
+	got, err := p.ParseContractDefinition()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.ContractBodyElements) != 1 {
+		t.Fatalf("got %d contract body elements, want 1", len(got.ContractBodyElements))
+	}
+
+	decl, ok := got.ContractBodyElements[0].(*ast.StateVariableDeclaration)
+	if !ok {
+		t.Fatalf("got %T, want *ast.StateVariableDeclaration", got.ContractBodyElements[0])
+	}
+	if diff := cmp.Diff(&ast.Visibility{Type: token.Public, Value: "public", Position: pos(62, 1)}, decl.Visibility); diff != "" {
+		t.Errorf("%s", diff)
+	}
+
+	ftn, ok := decl.TypeName.(*ast.FunctionTypeName)
+	if !ok {
+		t.Fatalf("got %T, want *ast.FunctionTypeName", decl.TypeName)
+	}
+	if diff := cmp.Diff(&ast.Visibility{Type: token.External, Value: "external", Position: pos(30, 1)}, ftn.ModifierList.Visibility()); diff != "" {
+		t.Errorf("%s", diff)
+	}
+	if diff := cmp.Diff(&ast.StateMutability{Type: token.Payable, Value: "payable", Position: pos(39, 1)}, ftn.ModifierList.StateMutability()); diff != "" {
+		t.Errorf("%s", diff)
+	}
+
+	if diff := cmp.Diff(pos(60, 1), ftn.End()); diff != "" {
+		t.Errorf("%s", diff)
+	}
+	if diff := cmp.Diff(pos(14, 1), decl.Pos()); diff != "" {
+		t.Errorf("%s", diff)
+	}
+	if diff := cmp.Diff(pos(71, 1), decl.End()); diff != "" {
+		t.Errorf("%s", diff)
+	}
+}
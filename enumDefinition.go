@@ -0,0 +1,69 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func (p *Parser) ParseEnumDefinition() (*ast.EnumDefinition, error) {
+	enm, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if enm.Type != token.Enum {
+		return nil, token.NewPosError(enm.Position, "not found enum keyword.")
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	lbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lbrace.Type != token.LBrace {
+		return nil, token.NewPosError(lbrace.Position, "not found LBrace.")
+	}
+
+	var vals []*ast.EnumValue
+	for {
+		v, err := p.ParseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+
+		cmm, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if cmm.Type != token.Comma {
+			vals = append(vals, &ast.EnumValue{
+				Identifier: v,
+			})
+			break
+		}
+		p.lexer.Scan()
+		vals = append(vals, &ast.EnumValue{
+			Identifier: v,
+			Comma:      &cmm.Position,
+		})
+	}
+
+	rbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rbrace.Type != token.RBrace {
+		return nil, token.NewPosError(rbrace.Position, "not found RBrace.")
+	}
+
+	return &ast.EnumDefinition{
+		Enum:       enm.Position,
+		Identifier: id,
+		LBrace:     lbrace.Position,
+		Values:     vals,
+		RBrace:     rbrace.Position,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+)
+
+func TestParser_ParseEnumDefinition(t *testing.T) {
+	tests := TestData[*ast.EnumDefinition]{
+		{
+			input: "enum Status { Active, Paused }",
+			want: &ast.EnumDefinition{
+				Enum:       pos(1, 1),
+				Identifier: *identPtr("Status", pos(6, 1)),
+				LBrace:     pos(13, 1),
+				Values: []*ast.EnumValue{
+					{Identifier: *identPtr("Active", pos(15, 1)), Comma: posPtr(21, 1)},
+					{Identifier: *identPtr("Paused", pos(23, 1))},
+				},
+				RBrace: pos(30, 1),
+			},
+		},
+		{
+			input: "struct S { A }",
+			err:   perr(pos(1, 1), "not found enum keyword."),
+		},
+		{
+			input: "enum S { A, }",
+			err:   perr(pos(13, 1), "keyword is not available as identifier."),
+		},
+		{
+			input: "enum S { A B }",
+			err:   perr(pos(12, 1), "not found RBrace."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.EnumDefinition, error) {
+		return p.ParseEnumDefinition()
+	})
+}
@@ -0,0 +1,125 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+// isErrorDefinition reports whether the next tokens start an error definition.
+// error is also available as an identifier, so it is a definition only if it is followed by an identifier and LParen.
+func (p *Parser) isErrorDefinition() (bool, error) {
+	tkn, err := p.lexer.Peek()
+	if err != nil || tkn.Type != token.Error {
+		return false, err
+	}
+
+	id, err := p.lexer.PeekN(2)
+	if err != nil || !isIdentifier(id) {
+		return false, err
+	}
+
+	lparen, err := p.lexer.PeekN(3)
+	if err != nil {
+		return false, err
+	}
+	return lparen.Type == token.LParen, nil
+}
+
+func (p *Parser) ParseErrorParameter() (*ast.ErrorParameter, error) {
+	tn, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	prm := &ast.ErrorParameter{
+		TypeName: tn,
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if isIdentifier(tkn) {
+		id, err := p.ParseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		prm.Identifier = &id
+	}
+
+	return prm, nil
+}
+
+func (p *Parser) ParseErrorDefinition() (*ast.ErrorDefinition, error) {
+	e, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if e.Type != token.Error {
+		return nil, token.NewPosError(e.Position, "not found error keyword.")
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var prms []*ast.ErrorParameter
+	if tkn.Type != token.RParen {
+		for {
+			prm, err := p.ParseErrorParameter()
+			if err != nil {
+				return nil, err
+			}
+			prms = append(prms, prm)
+
+			cmm, err := p.lexer.Peek()
+			if err != nil {
+				return nil, err
+			}
+			if cmm.Type != token.Comma {
+				break
+			}
+			p.lexer.Scan()
+			prm.Comma = &cmm.Position
+		}
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.ErrorDefinition{
+		Error:      e.Position,
+		Identifier: id,
+		LParen:     lparen.Position,
+		Parameters: prms,
+		RParen:     rparen.Position,
+		Semicolon:  semi.Position,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseErrorDefinition(t *testing.T) {
+	tests := TestData[*ast.ErrorDefinition]{
+		{
+			input: "error Unauthorized(address caller);",
+			want: &ast.ErrorDefinition{
+				Error:      pos(1, 1),
+				Identifier: *identPtr("Unauthorized", pos(7, 1)),
+				LParen:     pos(19, 1),
+				Parameters: []*ast.ErrorParameter{
+					{
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(20, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+						Identifier: identPtr("caller", pos(28, 1)),
+					},
+				},
+				RParen:    pos(34, 1),
+				Semicolon: pos(35, 1),
+			},
+		},
+		{
+			input: "error E(bool, string);",
+			want: &ast.ErrorDefinition{
+				Error:      pos(1, 1),
+				Identifier: *identPtr("E", pos(7, 1)),
+				LParen:     pos(8, 1),
+				Parameters: []*ast.ErrorParameter{
+					{
+						TypeName: ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(9, 1)), Kind: ast.ElementaryTypeNameKindBool},
+						Comma:    posPtr(13, 1),
+					},
+					{
+						TypeName: ast.ElementaryTypeName{Token: tkn(token.String, "string", pos(15, 1)), Kind: ast.ElementaryTypeNameKindString},
+					},
+				},
+				RParen:    pos(21, 1),
+				Semicolon: pos(22, 1),
+			},
+		},
+		{
+			input: "event E();",
+			err:   perr(pos(1, 1), "not found error keyword."),
+		},
+		{
+			input: "error E(bool indexed);",
+			err:   perr(pos(14, 1), "not found RParen."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.ErrorDefinition, error) {
+		return p.ParseErrorDefinition()
+	})
+}
@@ -0,0 +1,126 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func (p *Parser) ParseEventParameter() (*ast.EventParameter, error) {
+	tn, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	prm := &ast.EventParameter{
+		TypeName: tn,
+	}
+
+	idx, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if idx.Type == token.Indexed {
+		p.lexer.Scan()
+		prm.Indexed = &idx.Position
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if isIdentifier(tkn) {
+		id, err := p.ParseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		prm.Identifier = &id
+	}
+
+	return prm, nil
+}
+
+func (p *Parser) ParseEventDefinition() (*ast.EventDefinition, error) {
+	evt, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if evt.Type != token.Event {
+		return nil, token.NewPosError(evt.Position, "not found event keyword.")
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var prms []*ast.EventParameter
+	if tkn.Type != token.RParen {
+		for {
+			prm, err := p.ParseEventParameter()
+			if err != nil {
+				return nil, err
+			}
+			prms = append(prms, prm)
+
+			cmm, err := p.lexer.Peek()
+			if err != nil {
+				return nil, err
+			}
+			if cmm.Type != token.Comma {
+				break
+			}
+			p.lexer.Scan()
+			prm.Comma = &cmm.Position
+		}
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+
+	anon, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var anonPos *token.Pos
+	if anon.Type == token.Anonymous {
+		p.lexer.Scan()
+		anonPos = &anon.Position
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.EventDefinition{
+		Event:      evt.Position,
+		Identifier: id,
+		LParen:     lparen.Position,
+		Parameters: prms,
+		RParen:     rparen.Position,
+		Anonymous:  anonPos,
+		Semicolon:  semi.Position,
+	}, nil
+}
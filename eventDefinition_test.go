@@ -0,0 +1,63 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseEventDefinition(t *testing.T) {
+	tests := TestData[*ast.EventDefinition]{
+		{
+			input: "event Transfer(address indexed src, address dst, bool) anonymous;",
+			want: &ast.EventDefinition{
+				Event:      pos(1, 1),
+				Identifier: *identPtr("Transfer", pos(7, 1)),
+				LParen:     pos(15, 1),
+				Parameters: []*ast.EventParameter{
+					{
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(16, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+						Indexed:    posPtr(24, 1),
+						Identifier: identPtr("src", pos(32, 1)),
+						Comma:      posPtr(35, 1),
+					},
+					{
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(37, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+						Identifier: identPtr("dst", pos(45, 1)),
+						Comma:      posPtr(48, 1),
+					},
+					{
+						TypeName: ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(50, 1)), Kind: ast.ElementaryTypeNameKindBool},
+					},
+				},
+				RParen:    pos(54, 1),
+				Anonymous: posPtr(56, 1),
+				Semicolon: pos(65, 1),
+			},
+		},
+		{
+			input: "event E();",
+			want: &ast.EventDefinition{
+				Event:      pos(1, 1),
+				Identifier: *identPtr("E", pos(7, 1)),
+				LParen:     pos(8, 1),
+				RParen:     pos(9, 1),
+				Semicolon:  pos(10, 1),
+			},
+		},
+		{
+			input: "error E();",
+			err:   perr(pos(1, 1), "not found event keyword."),
+		},
+		{
+			input: "event E(bool) anon;",
+			err:   perr(pos(15, 1), "not found semicolon."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.EventDefinition, error) {
+		return p.ParseEventDefinition()
+	})
+}
@@ -26,8 +26,8 @@ contract HelloWorld {
 		return
 	}
 
-	fmt.Println(got.ContractDefinition.Identifier.Value)
-	fmt.Println(got.ContractDefinition.ContractBodyElements[0].(*ast.FunctionDefinition).FunctionDescriptor.Value)
+	fmt.Println(got.ContractDefinitions[0].Identifier.Value)
+	fmt.Println(got.ContractDefinitions[0].ContractBodyElements[0].(*ast.FunctionDefinition).FunctionDescriptor.Value)
 
 	// Output:
 	// HelloWorld
@@ -5,15 +5,578 @@ import (
 	"github.com/uji/solparser/token"
 )
 
+// binaryPrecedence returns the binding power of binary operators.
+// Operators with a larger value bind tighter. It returns 0 if tp is not a binary operator.
+func binaryPrecedence(tp token.TokenType) int {
+	switch tp {
+	case token.Or:
+		return 1
+	case token.And:
+		return 2
+	case token.Equal, token.NotEqual:
+		return 3
+	case token.LessThan, token.GreaterThan, token.LessThanOrEqual, token.GreaterThanOrEqual:
+		return 4
+	case token.BitOr:
+		return 5
+	case token.BitXor:
+		return 6
+	case token.BitAnd:
+		return 7
+	case token.Shl, token.Sar, token.Shr:
+		return 8
+	case token.Add, token.Sub:
+		return 9
+	case token.Mul, token.Div, token.Mod:
+		return 10
+	case token.Exp:
+		return 11
+	}
+	return 0
+}
+
+func isAssignmentOperator(tp token.TokenType) bool {
+	switch tp {
+	case token.Assign, token.AssignBitOr, token.AssignBitXor, token.AssignBitAnd,
+		token.AssignShl, token.AssignSar, token.AssignShr,
+		token.AssignAdd, token.AssignSub, token.AssignMul, token.AssignDiv, token.AssignMod:
+		return true
+	}
+	return false
+}
+
+func isPrefixOperator(tp token.TokenType) bool {
+	switch tp {
+	case token.Not, token.BitNot, token.Delete, token.Sub, token.Inc, token.Dec:
+		return true
+	}
+	return false
+}
+
+// isExpressionStart reports whether an expression can start with tkn.
+func isExpressionStart(tkn token.Token) bool {
+	if isPrefixOperator(tkn.Type) || isIdentifier(tkn) || isElementaryTypeName(tkn) {
+		return true
+	}
+	switch tkn.Type {
+	case token.NonEmptyStringLiteral, token.EmptyStringLiteral, token.HexString, token.UnicodeStringLiteral,
+		token.Number, token.TrueLiteral, token.FalseLiteral,
+		token.LParen, token.LBrack, token.NewKeyword, token.Type, token.Payable:
+		return true
+	}
+	return false
+}
+
+// ParseExpression parses an expression including conditional and assignment expressions.
+// Both of them are right associative.
 func (p *Parser) ParseExpression() (ast.Expression, error) {
+	exp, err := p.parseBinaryExpression(1)
+	if err != nil {
+		return nil, err
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	if tkn.Type == token.Conditional {
+		p.lexer.Scan()
+
+		tExp, err := p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		cln, err := p.lexer.Scan()
+		if err != nil {
+			return nil, err
+		}
+		if cln.Type != token.Colon {
+			return nil, token.NewPosError(cln.Position, "not found colon.")
+		}
+
+		fExp, err := p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ast.ConditionalExpression{
+			Condition:       exp,
+			Question:        tkn.Position,
+			TrueExpression:  tExp,
+			Colon:           cln.Position,
+			FalseExpression: fExp,
+		}, nil
+	}
+
+	if isAssignmentOperator(tkn.Type) {
+		p.lexer.Scan()
+
+		rExp, err := p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ast.AssignmentExpression{
+			Left:     exp,
+			Operator: tkn,
+			Right:    rExp,
+		}, nil
+	}
+
+	return exp, nil
+}
+
+// parseBinaryExpression parses binary expressions by precedence climbing.
+// Only operators whose precedence is minPrec or higher are consumed.
+func (p *Parser) parseBinaryExpression(minPrec int) (ast.Expression, error) {
+	lhs, err := p.parseUnaryExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		prec := binaryPrecedence(op.Type)
+		if prec == 0 || prec < minPrec {
+			return lhs, nil
+		}
+		p.lexer.Scan()
+
+		// ** is right associative, the others are left associative.
+		nextPrec := prec + 1
+		if op.Type == token.Exp {
+			nextPrec = prec
+		}
+
+		rhs, err := p.parseBinaryExpression(nextPrec)
+		if err != nil {
+			return nil, err
+		}
+
+		lhs = &ast.BinaryExpression{
+			Left:     lhs,
+			Operator: op,
+			Right:    rhs,
+		}
+	}
+}
+
+func (p *Parser) parseUnaryExpression() (ast.Expression, error) {
+	op, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	if isPrefixOperator(op.Type) {
+		p.lexer.Scan()
+
+		exp, err := p.parseUnaryExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ast.UnaryExpression{
+			Operator: op,
+			Operand:  exp,
+		}, nil
+	}
+
+	exp, err := p.parsePostfixExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if op.Type != token.Inc && op.Type != token.Dec {
+			return exp, nil
+		}
+		p.lexer.Scan()
+
+		exp = &ast.UnaryExpression{
+			Operator: op,
+			Operand:  exp,
+			Postfix:  true,
+		}
+	}
+}
+
+// parsePostfixExpression parses a primary expression followed by
+// member accesses, index accesses, function call options and function calls.
+func (p *Parser) parsePostfixExpression() (ast.Expression, error) {
+	exp, err := p.parsePrimaryExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		switch tkn.Type {
+		case token.Period:
+			exp, err = p.parseMemberAccess(exp)
+		case token.LBrack:
+			exp, err = p.parseIndexAccess(exp)
+		case token.LBrace:
+			// { is a block rather than call options if it is not followed by Identifier : (e.g. try f() { })
+			var isOpts bool
+			isOpts, err = p.isCallOptions()
+			if err != nil {
+				return nil, err
+			}
+			if !isOpts {
+				return exp, nil
+			}
+			var opts *ast.CallArgumentListNamedExpretions
+			opts, err = p.ParseCallArgumentListNamedExpretions()
+			exp = &ast.FunctionCallOptions{
+				Expression: exp,
+				Options:    opts,
+			}
+		case token.LParen:
+			var cal *ast.CallArgumentList
+			cal, err = p.ParseCallArgumentList()
+			exp = &ast.FunctionCall{
+				Expression:       exp,
+				CallArgumentList: cal,
+			}
+		default:
+			return exp, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isCallOptions reports whether the next tokens are { Identifier :.
+func (p *Parser) isCallOptions() (bool, error) {
+	id, err := p.lexer.PeekN(2)
+	if err != nil {
+		return false, err
+	}
+	cln, err := p.lexer.PeekN(3)
+	if err != nil {
+		return false, err
+	}
+	return isIdentifier(id) && cln.Type == token.Colon, nil
+}
+
+func (p *Parser) parseMemberAccess(exp ast.Expression) (*ast.MemberAccess, error) {
+	prd, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if prd.Type != token.Period {
+		return nil, token.NewPosError(prd.Position, "not found period.")
+	}
+
+	mbr, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	// address is available as a member name. (e.g. this.f.address)
+	if !isIdentifier(mbr) && mbr.Type != token.Address {
+		return nil, token.NewPosError(mbr.Position, "not found member name.")
+	}
+
+	return &ast.MemberAccess{
+		Expression: exp,
+		Period:     prd.Position,
+		Member:     ast.Identifier(mbr),
+	}, nil
+}
+
+// parseIndexAccess parses Expression[Index] or Expression[Start:End].
+func (p *Parser) parseIndexAccess(exp ast.Expression) (ast.Expression, error) {
+	lbrack, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lbrack.Type != token.LBrack {
+		return nil, token.NewPosError(lbrack.Position, "not found LBrack.")
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var idx ast.Expression
+	if tkn.Type != token.RBrack && tkn.Type != token.Colon {
+		idx, err = p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tkn, err = p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if tkn.Type == token.RBrack {
+		return &ast.IndexAccess{
+			Expression: exp,
+			LBrack:     lbrack.Position,
+			Index:      idx,
+			RBrack:     tkn.Position,
+		}, nil
+	}
+	if tkn.Type != token.Colon {
+		return nil, token.NewPosError(tkn.Position, "not found RBrack.")
+	}
+
+	rbrack, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var end ast.Expression
+	if rbrack.Type != token.RBrack {
+		end, err = p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rbrack, err = p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rbrack.Type != token.RBrack {
+		return nil, token.NewPosError(rbrack.Position, "not found RBrack.")
+	}
+
+	return &ast.IndexRangeAccess{
+		Expression:      exp,
+		LBrack:          lbrack.Position,
+		StartExpression: idx,
+		Colon:           tkn.Position,
+		EndExpression:   end,
+		RBrack:          rbrack.Position,
+	}, nil
+}
+
+func (p *Parser) parsePrimaryExpression() (ast.Expression, error) {
 	tkn, err := p.lexer.Peek()
 	if err != nil {
 		return nil, err
 	}
 
-	if tkn.Type == token.NonEmptyStringLiteral {
+	switch tkn.Type {
+	case token.NonEmptyStringLiteral, token.EmptyStringLiteral, token.HexString, token.UnicodeStringLiteral,
+		token.Number, token.TrueLiteral, token.FalseLiteral:
 		return p.ParseLiteral()
 	}
 
+	switch tkn.Type {
+	case token.LParen:
+		return p.parseTupleExpression()
+	case token.LBrack:
+		return p.parseInlineArrayExpression()
+	case token.NewKeyword:
+		return p.parseNewExpression()
+	case token.Type:
+		return p.parseMetaTypeExpression()
+	case token.Payable:
+		// payable(x) converts x to address payable.
+		p.lexer.Scan()
+		return &ast.ElementaryTypeNameExpression{
+			TypeName: ast.ElementaryTypeName{
+				Token: tkn,
+				Kind:  ast.ElementaryTypeNameKindAddress,
+			},
+		}, nil
+	}
+
+	if isElementaryTypeName(tkn) {
+		tn, err := p.ParseElementaryTypeName()
+		if err != nil {
+			return nil, err
+		}
+		return &ast.ElementaryTypeNameExpression{
+			TypeName: tn.(ast.ElementaryTypeName),
+		}, nil
+	}
+
+	if isIdentifier(tkn) {
+		id, err := p.ParseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		return &id, nil
+	}
+
 	return nil, token.NewPosError(tkn.Position, "not found expression.")
 }
+
+// parseTupleExpression parses ( Expression? ( , Expression? )* ).
+// Components are empty when the expression is omitted. (e.g. (a, , c))
+func (p *Parser) parseTupleExpression() (*ast.TupleExpression, error) {
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if tkn.Type == token.RParen {
+		p.lexer.Scan()
+		return &ast.TupleExpression{
+			LParen: lparen.Position,
+			RParen: tkn.Position,
+		}, nil
+	}
+
+	var cmps []*ast.TupleComponent
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		var exp ast.Expression
+		if tkn.Type != token.Comma && tkn.Type != token.RParen {
+			exp, err = p.ParseExpression()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		cmm, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if cmm.Type != token.Comma {
+			cmps = append(cmps, &ast.TupleComponent{
+				Expression: exp,
+			})
+			break
+		}
+		p.lexer.Scan()
+		cmps = append(cmps, &ast.TupleComponent{
+			Expression: exp,
+			Comma:      &cmm.Position,
+		})
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+
+	return &ast.TupleExpression{
+		LParen:     lparen.Position,
+		Components: cmps,
+		RParen:     rparen.Position,
+	}, nil
+}
+
+func (p *Parser) parseInlineArrayExpression() (*ast.InlineArrayExpression, error) {
+	lbrack, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lbrack.Type != token.LBrack {
+		return nil, token.NewPosError(lbrack.Position, "not found LBrack.")
+	}
+
+	exs, err := p.ParseCallArgumentListExpretions()
+	if err != nil {
+		return nil, err
+	}
+
+	rbrack, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rbrack.Type != token.RBrack {
+		return nil, token.NewPosError(rbrack.Position, "not found RBrack.")
+	}
+
+	return &ast.InlineArrayExpression{
+		LBrack:      lbrack.Position,
+		Expressions: exs,
+		RBrack:      rbrack.Position,
+	}, nil
+}
+
+// parseNewExpression parses new TypeName.
+// The arguments of the constructor are parsed as a function call by parsePostfixExpression.
+func (p *Parser) parseNewExpression() (*ast.NewExpression, error) {
+	nw, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if nw.Type != token.NewKeyword {
+		return nil, token.NewPosError(nw.Position, "not found new.")
+	}
+
+	tn, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.NewExpression{
+		New:      nw.Position,
+		TypeName: tn,
+	}, nil
+}
+
+func (p *Parser) parseMetaTypeExpression() (*ast.MetaTypeExpression, error) {
+	tp, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if tp.Type != token.Type {
+		return nil, token.NewPosError(tp.Position, "not found type.")
+	}
+
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	tn, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+
+	return &ast.MetaTypeExpression{
+		Type:     tp.Position,
+		LParen:   lparen.Position,
+		TypeName: tn,
+		RParen:   rparen.Position,
+	}, nil
+}
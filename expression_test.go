@@ -61,3 +61,499 @@ func TestParser_ParseExpression(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_ParseExpression_Operators(t *testing.T) {
+	tests := TestData[ast.Expression]{
+		{
+			input: "a + b * c;",
+			want: &ast.BinaryExpression{
+				Left:     identPtr("a", pos(1, 1)),
+				Operator: tkn(token.Add, "+", pos(3, 1)),
+				Right: &ast.BinaryExpression{
+					Left:     identPtr("b", pos(5, 1)),
+					Operator: tkn(token.Mul, "*", pos(7, 1)),
+					Right:    identPtr("c", pos(9, 1)),
+				},
+			},
+		},
+		{
+			input: "a - b - c",
+			want: &ast.BinaryExpression{
+				Left: &ast.BinaryExpression{
+					Left:     identPtr("a", pos(1, 1)),
+					Operator: tkn(token.Sub, "-", pos(3, 1)),
+					Right:    identPtr("b", pos(5, 1)),
+				},
+				Operator: tkn(token.Sub, "-", pos(7, 1)),
+				Right:    identPtr("c", pos(9, 1)),
+			},
+		},
+		{
+			input: "a ** b ** c",
+			want: &ast.BinaryExpression{
+				Left:     identPtr("a", pos(1, 1)),
+				Operator: tkn(token.Exp, "**", pos(3, 1)),
+				Right: &ast.BinaryExpression{
+					Left:     identPtr("b", pos(6, 1)),
+					Operator: tkn(token.Exp, "**", pos(8, 1)),
+					Right:    identPtr("c", pos(11, 1)),
+				},
+			},
+		},
+		{
+			input: "-a ** b",
+			want: &ast.BinaryExpression{
+				Left: &ast.UnaryExpression{
+					Operator: tkn(token.Sub, "-", pos(1, 1)),
+					Operand:  identPtr("a", pos(2, 1)),
+				},
+				Operator: tkn(token.Exp, "**", pos(4, 1)),
+				Right:    identPtr("b", pos(7, 1)),
+			},
+		},
+		{
+			input: "a || b && c == d",
+			want: &ast.BinaryExpression{
+				Left:     identPtr("a", pos(1, 1)),
+				Operator: tkn(token.Or, "||", pos(3, 1)),
+				Right: &ast.BinaryExpression{
+					Left:     identPtr("b", pos(6, 1)),
+					Operator: tkn(token.And, "&&", pos(8, 1)),
+					Right: &ast.BinaryExpression{
+						Left:     identPtr("c", pos(11, 1)),
+						Operator: tkn(token.Equal, "==", pos(13, 1)),
+						Right:    identPtr("d", pos(16, 1)),
+					},
+				},
+			},
+		},
+		{
+			input: "a | b ^ c & d << e",
+			want: &ast.BinaryExpression{
+				Left:     identPtr("a", pos(1, 1)),
+				Operator: tkn(token.BitOr, "|", pos(3, 1)),
+				Right: &ast.BinaryExpression{
+					Left:     identPtr("b", pos(5, 1)),
+					Operator: tkn(token.BitXor, "^", pos(7, 1)),
+					Right: &ast.BinaryExpression{
+						Left:     identPtr("c", pos(9, 1)),
+						Operator: tkn(token.BitAnd, "&", pos(11, 1)),
+						Right: &ast.BinaryExpression{
+							Left:     identPtr("d", pos(13, 1)),
+							Operator: tkn(token.Shl, "<<", pos(15, 1)),
+							Right:    identPtr("e", pos(18, 1)),
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "a <= b != c >= d",
+			want: &ast.BinaryExpression{
+				Left: &ast.BinaryExpression{
+					Left:     identPtr("a", pos(1, 1)),
+					Operator: tkn(token.LessThanOrEqual, "<=", pos(3, 1)),
+					Right:    identPtr("b", pos(6, 1)),
+				},
+				Operator: tkn(token.NotEqual, "!=", pos(8, 1)),
+				Right: &ast.BinaryExpression{
+					Left:     identPtr("c", pos(11, 1)),
+					Operator: tkn(token.GreaterThanOrEqual, ">=", pos(13, 1)),
+					Right:    identPtr("d", pos(16, 1)),
+				},
+			},
+		},
+		{
+			input: "!a++",
+			want: &ast.UnaryExpression{
+				Operator: tkn(token.Not, "!", pos(1, 1)),
+				Operand: &ast.UnaryExpression{
+					Operator: tkn(token.Inc, "++", pos(3, 1)),
+					Operand:  identPtr("a", pos(2, 1)),
+					Postfix:  true,
+				},
+			},
+		},
+		{
+			input: "delete a",
+			want: &ast.UnaryExpression{
+				Operator: tkn(token.Delete, "delete", pos(1, 1)),
+				Operand:  identPtr("a", pos(8, 1)),
+			},
+		},
+		{
+			input: "a ? b : c ? d : e",
+			want: &ast.ConditionalExpression{
+				Condition:      identPtr("a", pos(1, 1)),
+				Question:       pos(3, 1),
+				TrueExpression: identPtr("b", pos(5, 1)),
+				Colon:          pos(7, 1),
+				FalseExpression: &ast.ConditionalExpression{
+					Condition:       identPtr("c", pos(9, 1)),
+					Question:        pos(11, 1),
+					TrueExpression:  identPtr("d", pos(13, 1)),
+					Colon:           pos(15, 1),
+					FalseExpression: identPtr("e", pos(17, 1)),
+				},
+			},
+		},
+		{
+			input: "a = b >>= c",
+			want: &ast.AssignmentExpression{
+				Left:     identPtr("a", pos(1, 1)),
+				Operator: tkn(token.Assign, "=", pos(3, 1)),
+				Right: &ast.AssignmentExpression{
+					Left:     identPtr("b", pos(5, 1)),
+					Operator: tkn(token.AssignSar, ">>=", pos(7, 1)),
+					Right:    identPtr("c", pos(11, 1)),
+				},
+			},
+		},
+		{
+			input: "a + b * 2",
+			want: &ast.BinaryExpression{
+				Left:     identPtr("a", pos(1, 1)),
+				Operator: tkn(token.Add, "+", pos(3, 1)),
+				Right: &ast.BinaryExpression{
+					Left:     identPtr("b", pos(5, 1)),
+					Operator: tkn(token.Mul, "*", pos(7, 1)),
+					Right: &ast.NumberLiteral{
+						Number: tkn(token.Number, "2", pos(9, 1)),
+					},
+				},
+			},
+		},
+		{
+			input: "a ? b c",
+			err:   perr(pos(7, 1), "not found colon."),
+		},
+		{
+			input: "a + ;",
+			err:   perr(pos(5, 1), "not found expression."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.Expression, error) {
+		return p.ParseExpression()
+	})
+}
+
+func TestParser_ParseExpression_Postfix(t *testing.T) {
+	tests := TestData[ast.Expression]{
+		{
+			input: "a.b.c",
+			want: &ast.MemberAccess{
+				Expression: &ast.MemberAccess{
+					Expression: identPtr("a", pos(1, 1)),
+					Period:     pos(2, 1),
+					Member:     ast.Identifier(tkn(token.Identifier, "b", pos(3, 1))),
+				},
+				Period: pos(4, 1),
+				Member: ast.Identifier(tkn(token.Identifier, "c", pos(5, 1))),
+			},
+		},
+		{
+			input: "arr[i]++",
+			want: &ast.UnaryExpression{
+				Operator: tkn(token.Inc, "++", pos(7, 1)),
+				Operand: &ast.IndexAccess{
+					Expression: identPtr("arr", pos(1, 1)),
+					LBrack:     pos(4, 1),
+					Index:      identPtr("i", pos(5, 1)),
+					RBrack:     pos(6, 1),
+				},
+				Postfix: true,
+			},
+		},
+		{
+			input: "msg.data[i:]",
+			want: &ast.IndexRangeAccess{
+				Expression: &ast.MemberAccess{
+					Expression: identPtr("msg", pos(1, 1)),
+					Period:     pos(4, 1),
+					Member:     ast.Identifier(tkn(token.Identifier, "data", pos(5, 1))),
+				},
+				LBrack:          pos(9, 1),
+				StartExpression: identPtr("i", pos(10, 1)),
+				Colon:           pos(11, 1),
+				RBrack:          pos(12, 1),
+			},
+		},
+		{
+			input: "b[:j]",
+			want: &ast.IndexRangeAccess{
+				Expression:    identPtr("b", pos(1, 1)),
+				LBrack:        pos(2, 1),
+				Colon:         pos(3, 1),
+				EndExpression: identPtr("j", pos(4, 1)),
+				RBrack:        pos(5, 1),
+			},
+		},
+		{
+			input: "foo(a, b) + c",
+			want: &ast.BinaryExpression{
+				Left: &ast.FunctionCall{
+					Expression: identPtr("foo", pos(1, 1)),
+					CallArgumentList: &ast.CallArgumentList{
+						LParen: pos(4, 1),
+						Elements: ast.CallArgumentListExpretions{
+							{Expression: identPtr("a", pos(5, 1)), Comma: posPtr(6, 1)},
+							{Expression: identPtr("b", pos(8, 1))},
+						},
+						RParen: pos(9, 1),
+					},
+				},
+				Operator: tkn(token.Add, "+", pos(11, 1)),
+				Right:    identPtr("c", pos(13, 1)),
+			},
+		},
+		{
+			input: "addr.call{value: v}(data)",
+			want: &ast.FunctionCall{
+				Expression: &ast.FunctionCallOptions{
+					Expression: &ast.MemberAccess{
+						Expression: identPtr("addr", pos(1, 1)),
+						Period:     pos(5, 1),
+						Member:     ast.Identifier(tkn(token.Identifier, "call", pos(6, 1))),
+					},
+					Options: &ast.CallArgumentListNamedExpretions{
+						LBrace: pos(10, 1),
+						NamedExpretions: []*ast.CallArgumentListNamedExpretion{
+							{
+								Identifier: ast.Identifier(tkn(token.Identifier, "value", pos(11, 1))),
+								Colon:      pos(16, 1),
+								Expression: identPtr("v", pos(18, 1)),
+							},
+						},
+						RBrace: pos(19, 1),
+					},
+				},
+				CallArgumentList: &ast.CallArgumentList{
+					LParen: pos(20, 1),
+					Elements: ast.CallArgumentListExpretions{
+						{Expression: identPtr("data", pos(21, 1))},
+					},
+					RParen: pos(25, 1),
+				},
+			},
+		},
+		{
+			input: "f().address",
+			want: &ast.MemberAccess{
+				Expression: &ast.FunctionCall{
+					Expression: identPtr("f", pos(1, 1)),
+					CallArgumentList: &ast.CallArgumentList{
+						LParen: pos(2, 1),
+						RParen: pos(3, 1),
+					},
+				},
+				Period: pos(4, 1),
+				Member: ast.Identifier(tkn(token.Address, "address", pos(5, 1))),
+			},
+		},
+		{
+			input: "a.pragma",
+			err:   perr(pos(3, 1), "not found member name."),
+		},
+		{
+			input: "a[i;",
+			err:   perr(pos(4, 1), "not found RBrack."),
+		},
+		{
+			input: `addr.call{value: }("")`,
+			err:   perr(pos(18, 1), "not found expression."),
+		},
+		{
+			input: "a{value: 1",
+			err:   perr(pos(11, 1), "not found RBrace."),
+		},
+		{
+			input: "a{value: 1, gas 2}",
+			err:   perr(pos(17, 1), "not found Colon."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.Expression, error) {
+		return p.ParseExpression()
+	})
+}
+
+func TestParser_ParseExpression_Primary(t *testing.T) {
+	tests := TestData[ast.Expression]{
+		{
+			input: "(a, , c)",
+			want: &ast.TupleExpression{
+				LParen: pos(1, 1),
+				Components: []*ast.TupleComponent{
+					{Expression: identPtr("a", pos(2, 1)), Comma: posPtr(3, 1)},
+					{Comma: posPtr(5, 1)},
+					{Expression: identPtr("c", pos(7, 1))},
+				},
+				RParen: pos(8, 1),
+			},
+		},
+		{
+			input: "(a,)",
+			want: &ast.TupleExpression{
+				LParen: pos(1, 1),
+				Components: []*ast.TupleComponent{
+					{Expression: identPtr("a", pos(2, 1)), Comma: posPtr(3, 1)},
+					{},
+				},
+				RParen: pos(4, 1),
+			},
+		},
+		{
+			input: "()",
+			want: &ast.TupleExpression{
+				LParen: pos(1, 1),
+				RParen: pos(2, 1),
+			},
+		},
+		{
+			input: "(a + b) * c",
+			want: &ast.BinaryExpression{
+				Left: &ast.TupleExpression{
+					LParen: pos(1, 1),
+					Components: []*ast.TupleComponent{
+						{
+							Expression: &ast.BinaryExpression{
+								Left:     identPtr("a", pos(2, 1)),
+								Operator: tkn(token.Add, "+", pos(4, 1)),
+								Right:    identPtr("b", pos(6, 1)),
+							},
+						},
+					},
+					RParen: pos(7, 1),
+				},
+				Operator: tkn(token.Mul, "*", pos(9, 1)),
+				Right:    identPtr("c", pos(11, 1)),
+			},
+		},
+		{
+			input: "[a, b][i]",
+			want: &ast.IndexAccess{
+				Expression: &ast.InlineArrayExpression{
+					LBrack: pos(1, 1),
+					Expressions: ast.CallArgumentListExpretions{
+						{Expression: identPtr("a", pos(2, 1)), Comma: posPtr(3, 1)},
+						{Expression: identPtr("b", pos(5, 1))},
+					},
+					RBrack: pos(6, 1),
+				},
+				LBrack: pos(7, 1),
+				Index:  identPtr("i", pos(8, 1)),
+				RBrack: pos(9, 1),
+			},
+		},
+		{
+			input: "new bytes(n)",
+			want: &ast.FunctionCall{
+				Expression: &ast.NewExpression{
+					New:      pos(1, 1),
+					TypeName: ast.ElementaryTypeName{Token: tkn(token.Bytes, "bytes", pos(5, 1)), Kind: ast.ElementaryTypeNameKindBytes},
+				},
+				CallArgumentList: &ast.CallArgumentList{
+					LParen: pos(10, 1),
+					Elements: ast.CallArgumentListExpretions{
+						{Expression: identPtr("n", pos(11, 1))},
+					},
+					RParen: pos(12, 1),
+				},
+			},
+		},
+		{
+			input: "type(address)",
+			want: &ast.MetaTypeExpression{
+				Type:     pos(1, 1),
+				LParen:   pos(5, 1),
+				TypeName: ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(6, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+				RParen:   pos(13, 1),
+			},
+		},
+		{
+			input: "payable(a)",
+			want: &ast.FunctionCall{
+				Expression: &ast.ElementaryTypeNameExpression{
+					TypeName: ast.ElementaryTypeName{Token: tkn(token.Payable, "payable", pos(1, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+				},
+				CallArgumentList: &ast.CallArgumentList{
+					LParen: pos(8, 1),
+					Elements: ast.CallArgumentListExpretions{
+						{Expression: identPtr("a", pos(9, 1))},
+					},
+					RParen: pos(10, 1),
+				},
+			},
+		},
+		{
+			input: "uint8(a)",
+			want: &ast.FunctionCall{
+				Expression: &ast.ElementaryTypeNameExpression{
+					TypeName: ast.ElementaryTypeName{
+						Token: tkn(token.Identifier, "uint8", pos(1, 1)),
+						Kind:  ast.ElementaryTypeNameKindUint,
+						Bits:  8,
+					},
+				},
+				CallArgumentList: &ast.CallArgumentList{
+					LParen: pos(6, 1),
+					Elements: ast.CallArgumentListExpretions{
+						{Expression: identPtr("a", pos(7, 1))},
+					},
+					RParen: pos(8, 1),
+				},
+			},
+		},
+		{
+			input: "address(this).balance",
+			want: &ast.MemberAccess{
+				Expression: &ast.FunctionCall{
+					Expression: &ast.ElementaryTypeNameExpression{
+						TypeName: ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(1, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+					},
+					CallArgumentList: &ast.CallArgumentList{
+						LParen: pos(8, 1),
+						Elements: ast.CallArgumentListExpretions{
+							{Expression: identPtr("this", pos(9, 1))},
+						},
+						RParen: pos(13, 1),
+					},
+				},
+				Period: pos(14, 1),
+				Member: ast.Identifier(tkn(token.Identifier, "balance", pos(15, 1))),
+			},
+		},
+		{
+			input: "(a, b;",
+			err:   perr(pos(6, 1), "not found RParen."),
+		},
+		{
+			input: "[a;",
+			err:   perr(pos(3, 1), "not found RBrack."),
+		},
+		{
+			input: "type(a)",
+			want: &ast.MetaTypeExpression{
+				Type:   pos(1, 1),
+				LParen: pos(5, 1),
+				TypeName: &ast.UserDefinedTypeName{
+					IdentifierPath: ast.IdentifierPath{
+						Elements: []*ast.IdentifierPathElement{
+							{Identifier: *identPtr("a", pos(6, 1))},
+						},
+					},
+				},
+				RParen: pos(7, 1),
+			},
+		},
+		{
+			input: "type(1)",
+			err:   perr(pos(6, 1), "not found type-name."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.Expression, error) {
+		return p.ParseExpression()
+	})
+}
@@ -5,32 +5,192 @@ import (
 	"github.com/uji/solparser/token"
 )
 
+func isVisibility(tp token.TokenType) bool {
+	switch tp {
+	case token.Internal, token.External, token.Public, token.Private:
+		return true
+	}
+	return false
+}
+
+func isStateMutability(tp token.TokenType) bool {
+	switch tp {
+	case token.Pure, token.View, token.Payable:
+		return true
+	}
+	return false
+}
+
 func (p *Parser) ParseVisibility() (ast.Visibility, error) {
 	tkn, err := p.lexer.Scan()
 	if err != nil {
-		return token.Token{}, err
+		return ast.Visibility{}, err
 	}
 
-	switch tkn.Type {
-	case token.Internal, token.External, token.Public, token.Private:
-		return tkn, nil
+	if isVisibility(tkn.Type) {
+		return ast.Visibility(tkn), nil
 	}
 
-	return token.Token{}, token.NewPosError(tkn.Position, "not found visibility keyword.")
+	return ast.Visibility{}, token.NewPosError(tkn.Position, "not found visibility keyword.")
 }
 
 func (p *Parser) ParseStateMutability() (ast.StateMutability, error) {
 	tkn, err := p.lexer.Scan()
 	if err != nil {
-		return token.Token{}, err
+		return ast.StateMutability{}, err
 	}
 
-	switch tkn.Type {
-	case token.Pure, token.View, token.Payable:
-		return tkn, nil
+	if isStateMutability(tkn.Type) {
+		return ast.StateMutability(tkn), nil
+	}
+
+	return ast.StateMutability{}, token.NewPosError(tkn.Position, "not found state-mutability keyword.")
+}
+
+func (p *Parser) ParseOverrideSpecifier() (*ast.OverrideSpecifier, error) {
+	ovr, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if ovr.Type != token.Override {
+		return nil, token.NewPosError(ovr.Position, "not found override keyword.")
+	}
+
+	lparen, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return &ast.OverrideSpecifier{
+			Override: ovr.Position,
+		}, nil
+	}
+	p.lexer.Scan()
+
+	var paths []*ast.OverrideSpecifierPath
+	for {
+		ip, err := p.ParseIdentifierPath()
+		if err != nil {
+			return nil, err
+		}
+
+		cmm, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if cmm.Type != token.Comma {
+			paths = append(paths, &ast.OverrideSpecifierPath{
+				IdentifierPath: ip,
+			})
+			break
+		}
+		p.lexer.Scan()
+		paths = append(paths, &ast.OverrideSpecifierPath{
+			IdentifierPath: ip,
+			Comma:          &cmm.Position,
+		})
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
 	}
 
-	return token.Token{}, token.NewPosError(tkn.Position, "not found state-mutability keyword.")
+	return &ast.OverrideSpecifier{
+		Override: ovr.Position,
+		LParen:   &lparen.Position,
+		Paths:    paths,
+		RParen:   &rparen.Position,
+	}, nil
+}
+
+func (p *Parser) ParseModifierInvocation() (*ast.ModifierInvocation, error) {
+	ip, err := p.ParseIdentifierPath()
+	if err != nil {
+		return nil, err
+	}
+
+	lparen, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return &ast.ModifierInvocation{
+			IdentifierPath: ip,
+		}, nil
+	}
+
+	cal, err := p.ParseCallArgumentList()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.ModifierInvocation{
+		IdentifierPath:   ip,
+		CallArgumentList: cal,
+	}, nil
+}
+
+// ParseModifierList parses specifiers of a function header until a token which is not a specifier.
+// Visibility, state mutability, virtual and override can be specified at most once.
+func (p *Parser) ParseModifierList() (*ast.ModifierList, error) {
+	ml := &ast.ModifierList{}
+
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case isVisibility(tkn.Type):
+			if ml.Visibility() != nil {
+				return nil, token.NewPosError(tkn.Position, "visibility already specified.")
+			}
+			vs, err := p.ParseVisibility()
+			if err != nil {
+				return nil, err
+			}
+			ml.Elements = append(ml.Elements, &vs)
+		case isStateMutability(tkn.Type):
+			if ml.StateMutability() != nil {
+				return nil, token.NewPosError(tkn.Position, "state mutability already specified.")
+			}
+			sm, err := p.ParseStateMutability()
+			if err != nil {
+				return nil, err
+			}
+			ml.Elements = append(ml.Elements, &sm)
+		case tkn.Type == token.Virtual:
+			if ml.Virtual() != nil {
+				return nil, token.NewPosError(tkn.Position, "virtual already specified.")
+			}
+			p.lexer.Scan()
+			ml.Elements = append(ml.Elements, &ast.Virtual{
+				Virtual: tkn.Position,
+			})
+		case tkn.Type == token.Override:
+			if ml.OverrideSpecifier() != nil {
+				return nil, token.NewPosError(tkn.Position, "override already specified.")
+			}
+			os, err := p.ParseOverrideSpecifier()
+			if err != nil {
+				return nil, err
+			}
+			ml.Elements = append(ml.Elements, os)
+		case isIdentifier(tkn):
+			mi, err := p.ParseModifierInvocation()
+			if err != nil {
+				return nil, err
+			}
+			ml.Elements = append(ml.Elements, mi)
+		default:
+			return ml, nil
+		}
+	}
 }
 
 func (p *Parser) ParseFunctionDefinitionReturns() (*ast.FunctionDefinitionReturns, error) {
@@ -74,6 +234,56 @@ func (p *Parser) ParseFunctionDefinitionReturns() (*ast.FunctionDefinitionReturn
 	}, nil
 }
 
+// parseParameters parses a parenthesized parameter list. (e.g. (uint a, bytes memory b))
+func (p *Parser) parseParameters() (lparen token.Pos, prms ast.ParameterList, rparen token.Pos, err error) {
+	lp, err := p.lexer.Scan()
+	if err != nil {
+		return token.Pos{}, nil, token.Pos{}, err
+	}
+	if lp.Type != token.LParen {
+		return token.Pos{}, nil, token.Pos{}, token.NewPosError(lp.Position, "not found arguments LParen.")
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return token.Pos{}, nil, token.Pos{}, err
+	}
+	if isTypeNameStart(tkn) {
+		prms, err = p.ParseParameterList()
+		if err != nil {
+			return token.Pos{}, nil, token.Pos{}, err
+		}
+	}
+
+	rp, err := p.lexer.Scan()
+	if err != nil {
+		return token.Pos{}, nil, token.Pos{}, err
+	}
+	if rp.Type != token.RParen {
+		return token.Pos{}, nil, token.Pos{}, token.NewPosError(rp.Position, "not found arguments RParen.")
+	}
+
+	return lp.Position, prms, rp.Position, nil
+}
+
+// parseFunctionBody parses a block, or a semicolon of a function without body.
+func (p *Parser) parseFunctionBody() (*ast.Block, *token.Pos, error) {
+	semi, err := p.lexer.Peek()
+	if err != nil {
+		return nil, nil, err
+	}
+	if semi.Type == token.Semicolon {
+		p.lexer.Scan()
+		return nil, &semi.Position, nil
+	}
+
+	b, err := p.ParseBlock()
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, nil, nil
+}
+
 func (p *Parser) ParseFunctionDefinition() (*ast.FunctionDefinition, error) {
 	from, err := p.lexer.Scan()
 	if err != nil {
@@ -95,50 +305,75 @@ func (p *Parser) ParseFunctionDefinition() (*ast.FunctionDefinition, error) {
 		return nil, token.NewPosError(dsc.Position, "not found function description.")
 	}
 
-	lparen, err := p.lexer.Scan()
+	lparen, prms, rparen, err := p.parseParameters()
 	if err != nil {
 		return nil, err
 	}
-	if lparen.Type != token.LParen {
-		return nil, token.NewPosError(lparen.Position, "not found arguments LParen.")
+
+	ml, err := p.ParseModifierList()
+	if err != nil {
+		return nil, err
 	}
 
-	rparen, err := p.lexer.Scan()
+	r, err := p.ParseFunctionDefinitionReturns()
 	if err != nil {
 		return nil, err
 	}
-	if rparen.Type != token.RParen {
-		return nil, token.NewPosError(rparen.Position, "not found arguments RParen.")
+
+	b, semi, err := p.parseFunctionBody()
+	if err != nil {
+		return nil, err
 	}
 
-	modifierList := &ast.ModifierList{
-		Visibility:      nil,
-		StateMutability: nil,
+	return &ast.FunctionDefinition{
+		From:               from.Position,
+		FunctionDescriptor: dsc,
+		LParen:             lparen,
+		Parameters:         prms,
+		RParen:             rparen,
+		ModifierList:       ml,
+		Returns:            r,
+		Block:              b,
+		Semicolon:          semi,
+	}, nil
+}
+
+// fallback and receive functions must be external.
+func isFallbackModifier(e ast.ModifierListElement) bool {
+	if v, ok := e.(*ast.Visibility); ok {
+		return v.Type == token.External
 	}
+	return true
+}
 
-	for {
-		tkn, err := p.lexer.Peek()
-		if err != nil {
-			return nil, err
-		}
+// receive functions must be external and payable.
+func isReceiveModifier(e ast.ModifierListElement) bool {
+	if s, ok := e.(*ast.StateMutability); ok {
+		return s.Type == token.Payable
+	}
+	return isFallbackModifier(e)
+}
 
-		switch tkn.Type {
-		case token.Internal, token.External, token.Public, token.Private:
-			vs, err := p.ParseVisibility()
-			if err != nil {
-				return nil, err
-			}
-			modifierList.Visibility = &vs
-			continue
-		case token.Pure, token.View, token.Payable:
-			sm, err := p.ParseStateMutability()
-			if err != nil {
-				return nil, err
-			}
-			modifierList.StateMutability = &sm
-			continue
-		}
-		break
+func (p *Parser) ParseFallbackFunctionDefinition() (*ast.FallbackFunctionDefinition, error) {
+	fb, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if fb.Type != token.Fallback {
+		return nil, token.NewPosError(fb.Position, "not found fallback keyword.")
+	}
+
+	lparen, prms, rparen, err := p.parseParameters()
+	if err != nil {
+		return nil, err
+	}
+
+	ml, err := p.ParseModifierList()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModifierList(ml, isFallbackModifier, "invalid fallback modifier."); err != nil {
+		return nil, err
 	}
 
 	r, err := p.ParseFunctionDefinitionReturns()
@@ -146,18 +381,67 @@ func (p *Parser) ParseFunctionDefinition() (*ast.FunctionDefinition, error) {
 		return nil, err
 	}
 
-	b, err := p.ParseBlock()
+	b, semi, err := p.parseFunctionBody()
 	if err != nil {
 		return nil, err
 	}
 
-	return &ast.FunctionDefinition{
-		From:               from.Position,
-		FunctionDescriptor: dsc,
-		LParen:             lparen.Position,
-		RParen:             rparen.Position,
-		ModifierList:       modifierList,
-		Returns:            r,
-		Block:              b,
+	return &ast.FallbackFunctionDefinition{
+		Fallback:     fb.Position,
+		LParen:       lparen,
+		Parameters:   prms,
+		RParen:       rparen,
+		ModifierList: ml,
+		Returns:      r,
+		Block:        b,
+		Semicolon:    semi,
+	}, nil
+}
+
+func (p *Parser) ParseReceiveFunctionDefinition() (*ast.ReceiveFunctionDefinition, error) {
+	rcv, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rcv.Type != token.Receive {
+		return nil, token.NewPosError(rcv.Position, "not found receive keyword.")
+	}
+
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found arguments LParen.")
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found arguments RParen.")
+	}
+
+	ml, err := p.ParseModifierList()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModifierList(ml, isReceiveModifier, "invalid receive modifier."); err != nil {
+		return nil, err
+	}
+
+	b, semi, err := p.parseFunctionBody()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.ReceiveFunctionDefinition{
+		Receive:      rcv.Position,
+		LParen:       lparen.Position,
+		RParen:       rparen.Position,
+		ModifierList: ml,
+		Block:        b,
+		Semicolon:    semi,
 	}, nil
 }
@@ -113,11 +113,12 @@ func TestParser_ParseFunctionDefinitionReturns(t *testing.T) {
 				ParameterList: []*ast.Parameter{
 					{
 						TypeName: ast.ElementaryTypeName{
-							{
+							Token: token.Token{
 								Type:     token.String,
 								Value:    "string",
 								Position: token.Pos{Column: 10, Line: 1},
 							},
+							Kind: ast.ElementaryTypeNameKindString,
 						},
 					},
 				},
@@ -187,15 +188,17 @@ func TestParser_ParseFunctionDefinition(t *testing.T) {
 				LParen: token.Pos{Column: 15, Line: 1},
 				RParen: token.Pos{Column: 16, Line: 1},
 				ModifierList: &ast.ModifierList{
-					Visibility: &token.Token{
-						Type:     token.Public,
-						Value:    "public",
-						Position: token.Pos{Column: 18, Line: 1},
-					},
-					StateMutability: &token.Token{
-						Type:     token.Pure,
-						Value:    "pure",
-						Position: token.Pos{Column: 25, Line: 1},
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{
+							Type:     token.Public,
+							Value:    "public",
+							Position: token.Pos{Column: 18, Line: 1},
+						},
+						&ast.StateMutability{
+							Type:     token.Pure,
+							Value:    "pure",
+							Position: token.Pos{Column: 25, Line: 1},
+						},
 					},
 				},
 				Returns: &ast.FunctionDefinitionReturns{
@@ -204,11 +207,12 @@ func TestParser_ParseFunctionDefinition(t *testing.T) {
 					ParameterList: []*ast.Parameter{
 						{
 							TypeName: ast.ElementaryTypeName{
-								{
+								Token: token.Token{
 									Type:     token.String,
 									Value:    "string",
 									Position: token.Pos{Column: 39, Line: 1},
 								},
+								Kind: ast.ElementaryTypeNameKindString,
 							},
 						},
 					},
@@ -217,7 +221,7 @@ func TestParser_ParseFunctionDefinition(t *testing.T) {
 				Block: &ast.Block{
 					LBracePos: token.Pos{Column: 47, Line: 1},
 					RBracePos: token.Pos{Column: 5, Line: 3},
-					Nodes: []ast.Node{
+					Nodes: []ast.Statement{
 						&ast.ReturnStatement{
 							From:    token.Pos{Column: 9, Line: 2},
 							SemiPos: token.Pos{Column: 31, Line: 2},
@@ -283,3 +287,330 @@ func TestParser_ParseFunctionDefinition(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_ParseFunctionDefinition_Parameters(t *testing.T) {
+	tests := TestData[*ast.FunctionDefinition]{
+		{
+			input: "function f(string memory s, bool) returns (bool ok) {}",
+			want: &ast.FunctionDefinition{
+				From:               pos(1, 1),
+				FunctionDescriptor: tkn(token.Identifier, "f", pos(10, 1)),
+				LParen:             pos(11, 1),
+				Parameters: ast.ParameterList{
+					{
+						TypeName:     ast.ElementaryTypeName{Token: tkn(token.String, "string", pos(12, 1)), Kind: ast.ElementaryTypeNameKindString},
+						DataLocation: tknPtr(token.Memory, "memory", pos(19, 1)),
+						Identifier:   identPtr("s", pos(26, 1)),
+						Comma:        posPtr(27, 1),
+					},
+					{
+						TypeName: ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(29, 1)), Kind: ast.ElementaryTypeNameKindBool},
+					},
+				},
+				RParen:       pos(33, 1),
+				ModifierList: &ast.ModifierList{},
+				Returns: &ast.FunctionDefinitionReturns{
+					From:   pos(35, 1),
+					LParen: pos(43, 1),
+					ParameterList: ast.ParameterList{
+						{
+							TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(44, 1)), Kind: ast.ElementaryTypeNameKindBool},
+							Identifier: identPtr("ok", pos(49, 1)),
+						},
+					},
+					RParen: pos(51, 1),
+				},
+				Block: &ast.Block{
+					LBracePos: pos(53, 1),
+					RBracePos: pos(54, 1),
+				},
+			},
+		},
+		{
+			input: "function f(bytes calldata) {}",
+			want: &ast.FunctionDefinition{
+				From:               pos(1, 1),
+				FunctionDescriptor: tkn(token.Identifier, "f", pos(10, 1)),
+				LParen:             pos(11, 1),
+				Parameters: ast.ParameterList{
+					{
+						TypeName:     ast.ElementaryTypeName{Token: tkn(token.Bytes, "bytes", pos(12, 1)), Kind: ast.ElementaryTypeNameKindBytes},
+						DataLocation: tknPtr(token.Calldata, "calldata", pos(18, 1)),
+					},
+				},
+				RParen:       pos(26, 1),
+				ModifierList: &ast.ModifierList{},
+				Block: &ast.Block{
+					LBracePos: pos(28, 1),
+					RBracePos: pos(29, 1),
+				},
+			},
+		},
+		{
+			input: "function f(bool a b) {}",
+			err:   perr(pos(19, 1), "not found arguments RParen."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.FunctionDefinition, error) {
+		return p.ParseFunctionDefinition()
+	})
+}
+
+func TestParser_ParseModifierList(t *testing.T) {
+	tests := TestData[*ast.ModifierList]{
+		{
+			input: "public view virtual override(A, b.C) onlyOwner whenNotPaused(x) {",
+			want: &ast.ModifierList{
+				Elements: []ast.ModifierListElement{
+					&ast.Visibility{Type: token.Public, Value: "public", Position: pos(1, 1)},
+					&ast.StateMutability{Type: token.View, Value: "view", Position: pos(8, 1)},
+					&ast.Virtual{Virtual: pos(13, 1)},
+					&ast.OverrideSpecifier{
+						Override: pos(21, 1),
+						LParen:   posPtr(29, 1),
+						Paths: []*ast.OverrideSpecifierPath{
+							{
+								IdentifierPath: ast.IdentifierPath{
+									Elements: []*ast.IdentifierPathElement{
+										{Identifier: *identPtr("A", pos(30, 1))},
+									},
+								},
+								Comma: posPtr(31, 1),
+							},
+							{
+								IdentifierPath: ast.IdentifierPath{
+									Elements: []*ast.IdentifierPathElement{
+										{Identifier: *identPtr("b", pos(33, 1)), Period: posPtr(34, 1)},
+										{Identifier: *identPtr("C", pos(35, 1))},
+									},
+								},
+							},
+						},
+						RParen: posPtr(36, 1),
+					},
+					&ast.ModifierInvocation{
+						IdentifierPath: ast.IdentifierPath{
+							Elements: []*ast.IdentifierPathElement{
+								{Identifier: *identPtr("onlyOwner", pos(38, 1))},
+							},
+						},
+					},
+					&ast.ModifierInvocation{
+						IdentifierPath: ast.IdentifierPath{
+							Elements: []*ast.IdentifierPathElement{
+								{Identifier: *identPtr("whenNotPaused", pos(48, 1))},
+							},
+						},
+						CallArgumentList: &ast.CallArgumentList{
+							LParen: pos(61, 1),
+							Elements: ast.CallArgumentListExpretions{
+								{Expression: identPtr("x", pos(62, 1))},
+							},
+							RParen: pos(63, 1),
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "override external",
+			want: &ast.ModifierList{
+				Elements: []ast.ModifierListElement{
+					&ast.OverrideSpecifier{Override: pos(1, 1)},
+					&ast.Visibility{Type: token.External, Value: "external", Position: pos(10, 1)},
+				},
+			},
+		},
+		{
+			input: "returns",
+			want:  &ast.ModifierList{},
+		},
+		{
+			input: "public private",
+			err:   perr(pos(8, 1), "visibility already specified."),
+		},
+		{
+			input: "view payable",
+			err:   perr(pos(6, 1), "state mutability already specified."),
+		},
+		{
+			input: "virtual virtual",
+			err:   perr(pos(9, 1), "virtual already specified."),
+		},
+		{
+			input: "override override(A)",
+			err:   perr(pos(10, 1), "override already specified."),
+		},
+		{
+			input: "override(A;",
+			err:   perr(pos(11, 1), "not found RParen."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.ModifierList, error) {
+		return p.ParseModifierList()
+	})
+}
+
+func TestParser_ParseFunctionDefinition_WithoutBody(t *testing.T) {
+	tests := TestData[*ast.FunctionDefinition]{
+		{
+			input: "function f() external view returns (bool);",
+			want: &ast.FunctionDefinition{
+				From:               pos(1, 1),
+				FunctionDescriptor: tkn(token.Identifier, "f", pos(10, 1)),
+				LParen:             pos(11, 1),
+				RParen:             pos(12, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{Type: token.External, Value: "external", Position: pos(14, 1)},
+						&ast.StateMutability{Type: token.View, Value: "view", Position: pos(23, 1)},
+					},
+				},
+				Returns: &ast.FunctionDefinitionReturns{
+					From:   pos(28, 1),
+					LParen: pos(36, 1),
+					ParameterList: ast.ParameterList{
+						{TypeName: ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(37, 1)), Kind: ast.ElementaryTypeNameKindBool}},
+					},
+					RParen: pos(41, 1),
+				},
+				Semicolon: posPtr(42, 1),
+			},
+		},
+		{
+			input: "function f() virtual;",
+			want: &ast.FunctionDefinition{
+				From:               pos(1, 1),
+				FunctionDescriptor: tkn(token.Identifier, "f", pos(10, 1)),
+				LParen:             pos(11, 1),
+				RParen:             pos(12, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Virtual{Virtual: pos(14, 1)},
+					},
+				},
+				Semicolon: posPtr(21, 1),
+			},
+		},
+		{
+			input: "function f() public public {}",
+			err:   perr(pos(21, 1), "visibility already specified."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.FunctionDefinition, error) {
+		return p.ParseFunctionDefinition()
+	})
+}
+
+func TestParser_ParseFallbackFunctionDefinition(t *testing.T) {
+	tests := TestData[*ast.FallbackFunctionDefinition]{
+		{
+			input: "fallback(bytes calldata input) external returns (bytes memory) {}",
+			want: &ast.FallbackFunctionDefinition{
+				Fallback: pos(1, 1),
+				LParen:   pos(9, 1),
+				Parameters: ast.ParameterList{
+					{
+						TypeName:     ast.ElementaryTypeName{Token: tkn(token.Bytes, "bytes", pos(10, 1)), Kind: ast.ElementaryTypeNameKindBytes},
+						DataLocation: tknPtr(token.Calldata, "calldata", pos(16, 1)),
+						Identifier:   identPtr("input", pos(25, 1)),
+					},
+				},
+				RParen: pos(30, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{Type: token.External, Value: "external", Position: pos(32, 1)},
+					},
+				},
+				Returns: &ast.FunctionDefinitionReturns{
+					From:   pos(41, 1),
+					LParen: pos(49, 1),
+					ParameterList: ast.ParameterList{
+						{
+							TypeName:     ast.ElementaryTypeName{Token: tkn(token.Bytes, "bytes", pos(50, 1)), Kind: ast.ElementaryTypeNameKindBytes},
+							DataLocation: tknPtr(token.Memory, "memory", pos(56, 1)),
+						},
+					},
+					RParen: pos(62, 1),
+				},
+				Block: &ast.Block{
+					LBracePos: pos(64, 1),
+					RBracePos: pos(65, 1),
+				},
+			},
+		},
+		{
+			input: "fallback() external virtual;",
+			want: &ast.FallbackFunctionDefinition{
+				Fallback: pos(1, 1),
+				LParen:   pos(9, 1),
+				RParen:   pos(10, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{Type: token.External, Value: "external", Position: pos(12, 1)},
+						&ast.Virtual{Virtual: pos(21, 1)},
+					},
+				},
+				Semicolon: posPtr(28, 1),
+			},
+		},
+		{
+			input: "function() {}",
+			err:   perr(pos(1, 1), "not found fallback keyword."),
+		},
+		{
+			input: "fallback() internal;",
+			err:   perr(pos(12, 1), "invalid fallback modifier."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.FallbackFunctionDefinition, error) {
+		return p.ParseFallbackFunctionDefinition()
+	})
+}
+
+func TestParser_ParseReceiveFunctionDefinition(t *testing.T) {
+	tests := TestData[*ast.ReceiveFunctionDefinition]{
+		{
+			input: "receive() external payable {}",
+			want: &ast.ReceiveFunctionDefinition{
+				Receive: pos(1, 1),
+				LParen:  pos(8, 1),
+				RParen:  pos(9, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{Type: token.External, Value: "external", Position: pos(11, 1)},
+						&ast.StateMutability{Type: token.Payable, Value: "payable", Position: pos(20, 1)},
+					},
+				},
+				Block: &ast.Block{
+					LBracePos: pos(28, 1),
+					RBracePos: pos(29, 1),
+				},
+			},
+		},
+		{
+			input: "function() {}",
+			err:   perr(pos(1, 1), "not found receive keyword."),
+		},
+		{
+			input: "receive(bool) external payable {}",
+			err:   perr(pos(9, 1), "not found arguments RParen."),
+		},
+		{
+			input: "receive() public payable {}",
+			err:   perr(pos(11, 1), "invalid receive modifier."),
+		},
+		{
+			input: "receive() external view {}",
+			err:   perr(pos(20, 1), "invalid receive modifier."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.ReceiveFunctionDefinition, error) {
+		return p.ParseReceiveFunctionDefinition()
+	})
+}
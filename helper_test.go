@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
 	"github.com/uji/solparser/token"
 )
 
@@ -34,6 +35,14 @@ func tknPtr(tp token.TokenType, text string, pos token.Pos) *token.Token {
 	}
 }
 
+func identPtr(text string, pos token.Pos) *ast.Identifier {
+	return &ast.Identifier{
+		Type:     token.Identifier,
+		Value:    text,
+		Position: pos,
+	}
+}
+
 func perr(pos token.Pos, msg string) *token.PosError {
 	return &token.PosError{
 		Pos: pos,
@@ -14,6 +14,10 @@ func TestParser_ParsePath(t *testing.T) {
 			input: `"test.sol"`,
 			want:  ast.Path(tkn(token.NonEmptyStringLiteral, `"test.sol"`, pos(1, 1))),
 		},
+		{
+			input: `'test.sol'`,
+			want:  ast.Path(tkn(token.NonEmptyStringLiteral, `'test.sol'`, pos(1, 1))),
+		},
 		{
 			input: "pragma",
 			err:   perr(pos(1, 1), "not found non-empty-string-literal."),
@@ -131,6 +135,16 @@ func TestParser_ParseImportDirective(t *testing.T) {
 				Semicolon: pos(18, 1),
 			},
 		},
+		{
+			input: `import 'a.sol';`,
+			want: &ast.ImportDirective{
+				Import: pos(1, 1),
+				Element: &ast.ImportDirectivePathElement{
+					Path: ast.Path(tkn(token.NonEmptyStringLiteral, `'a.sol'`, pos(8, 1))),
+				},
+				Semicolon: pos(15, 1),
+			},
+		},
 		{
 			input: `import {symbol1} from "test.sol";`,
 			want: &ast.ImportDirective{
@@ -0,0 +1,57 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseInheritanceSpecifire(t *testing.T) {
+	tests := TestData[*ast.InheritanceSpecifier]{
+		{
+			input: "Ownable",
+			want: &ast.InheritanceSpecifier{
+				IdentifierPath: ast.IdentifierPath{
+					Elements: []*ast.IdentifierPathElement{
+						{Identifier: *identPtr("Ownable", pos(1, 1))},
+					},
+				},
+			},
+		},
+		{
+			input: `ERC20("T", 1)`,
+			want: &ast.InheritanceSpecifier{
+				IdentifierPath: ast.IdentifierPath{
+					Elements: []*ast.IdentifierPathElement{
+						{Identifier: *identPtr("ERC20", pos(1, 1))},
+					},
+				},
+				CallArgumentList: &ast.CallArgumentList{
+					LParen: pos(6, 1),
+					Elements: ast.CallArgumentListExpretions{
+						{
+							Expression: &ast.StringLiteral{
+								Type:     token.NonEmptyStringLiteral,
+								Value:    `"T"`,
+								Position: pos(7, 1),
+							},
+							Comma: posPtr(10, 1),
+						},
+						{Expression: &ast.NumberLiteral{Number: tkn(token.Number, "1", pos(12, 1))}},
+					},
+					RParen: pos(13, 1),
+				},
+			},
+		},
+		{
+			input: "{",
+			err:   perr(pos(1, 1), "keyword is not available as identifier."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.InheritanceSpecifier, error) {
+		return p.ParseInheritanceSpecifire()
+	})
+}
@@ -3,19 +3,39 @@ package lexer
 import (
 	"errors"
 	"io"
+	"regexp"
+	"strings"
 	"unicode"
 
+	"github.com/SteelSeries/bufrr"
 	"github.com/uji/solparser/scanner"
 	"github.com/uji/solparser/token"
 )
 
+// Mode is the language which the lexer tokenizes.
+type Mode int
+
+const (
+	SolidityMode Mode = iota
+	// YulMode joins := and identifiers including periods (e.g. x.slot), and uses Yul keywords.
+	YulMode
+)
+
 type Lexer struct {
 	scanner *scanner.Scanner
+	mode    Mode
+	// inPragma is true between pragma and the following semicolon,
+	// where strings beginning with a digit are versions rather than numbers. (e.g. 0.8.13)
+	inPragma bool
 
 	// peek state
-	peeked    bool
-	peekToken token.Token
-	peekErr   error
+	// peeked holds the tokens read ahead by Peek and PeekN in order.
+	peeked []peekResult
+}
+
+type peekResult struct {
+	token token.Token
+	err   error
 }
 
 func New(input io.Reader) *Lexer {
@@ -35,39 +55,154 @@ func (l *Lexer) scan() (tkn token.Token, err error) {
 		return token.Token{}, errors.New("Empty character scanned.")
 	}
 
-	if str == `"` || str == `\"` {
+	if str == `"` || str == `'` {
 		return l.ScanStringLiteral()
 	}
 
 	l.scanner.Scan()
 
+	// hex and unicode are prefixes of string literals only if a quote follows without spaces.
+	if str == "hex" || str == "unicode" {
+		qpos, q, err := l.scanner.Peek()
+		if err != nil {
+			return token.Token{}, err
+		}
+		if (q == `"` || q == `'`) && qpos.Line == pos.Line && qpos.Column == pos.Column+len(str) {
+			if str == "hex" {
+				return l.scanHexString(pos, str)
+			}
+			return l.scanUnicodeStringLiteral(pos, str)
+		}
+	}
+
 	// If space, scan for the next token
 	if token.IsSpace([]rune(str)[0]) {
 		return l.scan()
 	}
 
-	return token.NewToken(str, pos), nil
+	if isNumber(str) {
+		return token.Token{
+			Type:     token.Number,
+			Value:    str,
+			Position: pos,
+		}, nil
+	}
+	if isNumberStart(str) && !l.inPragma {
+		return token.Token{}, token.NewPosError(pos, "invalid number literal.")
+	}
+
+	if l.mode == YulMode {
+		return l.scanYul(pos, str)
+	}
+
+	tkn = token.NewToken(str, pos)
+	switch tkn.Type {
+	case token.Pragma:
+		l.inPragma = true
+	case token.Semicolon:
+		l.inPragma = false
+	}
+	return tkn, nil
+}
+
+// SetMode switches the language which the lexer tokenizes.
+// It is not available while tokens are peeked, because they have been tokenized in the previous mode.
+func (l *Lexer) SetMode(mode Mode) error {
+	if len(l.peeked) > 0 {
+		return errors.New("Mode is not available to switch while tokens are peeked.")
+	}
+	l.mode = mode
+	return nil
+}
+
+func isYulIdentifierRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$'
+}
+
+// scanYul scans the rest of the token whose first string is str in Yul mode.
+func (l *Lexer) scanYul(pos token.Pos, str string) (token.Token, error) {
+	if str == ":" {
+		epos, eq, err := l.scanner.Peek()
+		if err != nil {
+			return token.Token{}, err
+		}
+		if eq == "=" && epos.Line == pos.Line && epos.Column == pos.Column+1 {
+			l.scanner.Scan()
+			return token.NewYulToken(":=", pos), nil
+		}
+		return token.NewYulToken(str, pos), nil
+	}
+
+	if !isYulIdentifierRune([]rune(str)[0]) {
+		return token.NewYulToken(str, pos), nil
+	}
+
+	// Periods and the following strings are parts of the identifier. (e.g. x.slot, abi.decode)
+	id := str
+	for {
+		npos, next, err := l.scanner.Peek()
+		if err != nil {
+			return token.Token{}, err
+		}
+		if npos.Line != pos.Line || npos.Column != pos.Column+len(id) {
+			break
+		}
+		if next == "" || next != "." && !isYulIdentifierRune([]rune(next)[0]) {
+			break
+		}
+		l.scanner.Scan()
+		id += next
+	}
+
+	return token.NewYulToken(id, pos), nil
+}
+
+var (
+	// DecimalNumber (e.g. 1, 1_000, 1.5, .5, 2e10, 1.5e-3)
+	decimalNumberRegexp = regexp.MustCompile(`^([0-9]+(_[0-9]+)*|([0-9]+(_[0-9]+)*)?\.[0-9]+(_[0-9]+)*)([eE]-?[0-9]+(_[0-9]+)*)?$`)
+	// HexNumber (e.g. 0x1f, 0xdead_beef)
+	hexNumberRegexp = regexp.MustCompile(`^0x[0-9a-fA-F]+(_[0-9a-fA-F]+)*$`)
+)
+
+// isNumber reports whether str is DecimalNumber or HexNumber.
+// Strings which begin with a digit but are not numbers (e.g. 0.8.13 of pragma directives) are not numbers.
+func isNumber(str string) bool {
+	return decimalNumberRegexp.MatchString(str) || hexNumberRegexp.MatchString(str)
+}
+
+// isNumberStart reports whether str begins like a number. (e.g. 1, .5)
+func isNumberStart(str string) bool {
+	return '0' <= str[0] && str[0] <= '9' || str[0] == '.' && len(str) > 1
 }
 
 func (l *Lexer) Scan() (token.Token, error) {
-	if l.peeked {
-		l.peeked = false
-		return l.peekToken, l.peekErr
+	if len(l.peeked) > 0 {
+		rslt := l.peeked[0]
+		l.peeked = l.peeked[1:]
+		return rslt.token, rslt.err
 	}
 
 	return l.scan()
 }
 
 func (l *Lexer) Peek() (token.Token, error) {
-	if l.peeked {
-		return l.peekToken, l.peekErr
+	return l.PeekN(1)
+}
+
+// PeekN reads ahead and returns the n-th token which Scan will return. (n >= 1)
+// PeekN(1) is the same as Peek.
+func (l *Lexer) PeekN(n int) (token.Token, error) {
+	if n < 1 {
+		return token.Token{}, errors.New("PeekN requires n >= 1.")
+	}
+
+	for len(l.peeked) < n {
+		tkn, err := l.scan()
+		l.peeked = append(l.peeked, peekResult{token: tkn, err: err})
 	}
 
-	tkn, err := l.scan()
-	l.peeked = true
-	l.peekToken = tkn
-	l.peekErr = err
-	return l.peekToken, l.peekErr
+	rslt := l.peeked[n-1]
+	return rslt.token, rslt.err
 }
 
 // ScanStringLiteral parse NonEmptyStringLiteral or EmptyStringLiteral then return StringLiteral token.
@@ -76,27 +211,102 @@ func (l *Lexer) ScanStringLiteral() (token.Token, error) {
 	if err != nil {
 		return token.Token{}, err
 	}
-	if v != `"` && v != `\'` {
-		return token.Token{}, token.NewPosError(start, `not found " or \'`)
+	if v != `"` && v != `'` {
+		return token.Token{}, token.NewPosError(start, `not found " or '`)
+	}
+
+	txt, err := l.scanStringContents(v, false)
+	if err != nil {
+		return token.Token{}, err
 	}
 
-	quote, txt := v, v
-	tokenType := token.EmptyStringLiteral
+	tokenType := token.NonEmptyStringLiteral
+	if txt == v {
+		tokenType = token.EmptyStringLiteral
+	}
+	return token.Token{
+		Type:     tokenType,
+		Value:    v + txt,
+		Position: start,
+	}, nil
+}
+
+// scanStringContents reads the contents of a quoted string after the opening quote
+// and returns them with the closing quote.
+// Characters other than printable ASCII are available only if unicode is true. (unicode-string-literal)
+func (l *Lexer) scanStringContents(quote string, unicode bool) (string, error) {
+	var txt strings.Builder
 	for {
-		_, v, err := l.scanner.Scan()
+		pos, r, err := l.scanner.ScanRune()
 		if err != nil {
-			return token.Token{}, err
+			return "", err
+		}
+
+		switch {
+		case r == bufrr.EOF:
+			return "", token.NewPosError(pos, "not found closing quote.")
+		case string(r) == quote:
+			txt.WriteRune(r)
+			return txt.String(), nil
+		case r == '\\':
+			esc, err := l.scanEscapeSequence(pos)
+			if err != nil {
+				return "", err
+			}
+			txt.WriteString(esc)
+		case r == '\n' || r == '\r' || !unicode && !isPrintable(r):
+			return "", token.NewPosError(pos, "invalid character in string literal.")
+		default:
+			txt.WriteRune(r)
+		}
+	}
+}
+
+// isPrintable reports whether r is a printable ASCII character.
+func isPrintable(r rune) bool {
+	return 0x20 <= r && r <= 0x7e
+}
+
+// scanEscapeSequence reads an escape sequence after the backslash at start, and returns it with the backslash.
+func (l *Lexer) scanEscapeSequence(start token.Pos) (string, error) {
+	_, r, err := l.scanner.ScanRune()
+	if err != nil {
+		return "", err
+	}
+
+	switch r {
+	case '\'', '"', '\\', 'n', 'r', 't', '\n':
+		return `\` + string(r), nil
+	case '\r':
+		next, err := l.scanner.PeekRune()
+		if err != nil {
+			return "", err
+		}
+		if next == '\n' {
+			l.scanner.ScanRune()
+			return "\\\r\n", nil
+		}
+		return "\\\r", nil
+	case 'x', 'u':
+		n := 2
+		if r == 'u' {
+			n = 4
 		}
-		txt = txt + v
-		if v == quote {
-			return token.Token{
-				Type:     tokenType,
-				Value:    txt,
-				Position: start,
-			}, nil
+		esc := []rune{'\\', r}
+		for i := 0; i < n; i++ {
+			_, h, err := l.scanner.ScanRune()
+			if err != nil {
+				return "", err
+			}
+			if !unicode.Is(unicode.Hex_Digit, h) {
+				return "", token.NewPosError(start, "invalid escape sequence.")
+			}
+			esc = append(esc, h)
 		}
-		tokenType = token.NonEmptyStringLiteral
+		return string(esc), nil
 	}
+
+	return "", token.NewPosError(start, "invalid escape sequence.")
 }
 
 const unicodeStringLiteralQuoteOffset = len("unicode")
@@ -109,33 +319,31 @@ func (l *Lexer) ScanUnicodeStringLiteral() (token.Token, error) {
 	if v != "unicode" {
 		return token.Token{}, token.NewPosError(start, "not found unicode prefix.")
 	}
-	rslt := v
 
+	return l.scanUnicodeStringLiteral(start, v)
+}
+
+// scanUnicodeStringLiteral scans the rest of UnicodeStringLiteral after the unicode prefix.
+func (l *Lexer) scanUnicodeStringLiteral(start token.Pos, prefix string) (token.Token, error) {
 	pos, v, err := l.scanner.Scan()
 	if err != nil {
 		return token.Token{}, err
 	}
 	// 'unicode' and quote('"' or '\'') must not have spaces.
-	if v != `"` && v != `\'` && pos.Line == start.Line || pos.Column != start.Column+unicodeStringLiteralQuoteOffset {
-		return token.Token{}, token.NewPosError(pos, `not found " or \'`)
+	if v != `"` && v != `'` || pos.Line != start.Line || pos.Column != start.Column+unicodeStringLiteralQuoteOffset {
+		return token.Token{}, token.NewPosError(pos, `not found " or '`)
 	}
 
-	rslt = rslt + v
-	quote := v
-	for {
-		_, v, err := l.scanner.Scan()
-		if err != nil {
-			return token.Token{}, err
-		}
-		rslt = rslt + v
-		if v == quote {
-			return token.Token{
-				Type:     token.UnicodeStringLiteral,
-				Value:    rslt,
-				Position: start,
-			}, nil
-		}
+	txt, err := l.scanStringContents(v, true)
+	if err != nil {
+		return token.Token{}, err
 	}
+
+	return token.Token{
+		Type:     token.UnicodeStringLiteral,
+		Value:    prefix + v + txt,
+		Position: start,
+	}, nil
 }
 
 func isEvenHexDigits(str string) bool {
@@ -174,18 +382,31 @@ func (l *Lexer) ScanHexString() (token.Token, error) {
 		return token.Token{}, token.NewPosError(start, "not found hex prefix.")
 	}
 
+	return l.scanHexString(start, hex)
+}
+
+// scanHexString scans the rest of HexString after the hex prefix.
+func (l *Lexer) scanHexString(start token.Pos, hex string) (token.Token, error) {
 	lqpos, lquote, err := l.scanner.Scan()
 	if err != nil {
 		return token.Token{}, err
 	}
-	if lquote != `"` && lquote != `\'` {
-		return token.Token{}, token.NewPosError(lqpos, `not found " or \'`)
+	if lquote != `"` && lquote != `'` {
+		return token.Token{}, token.NewPosError(lqpos, `not found " or '`)
 	}
 
 	vpos, v, err := l.scanner.Scan()
 	if err != nil {
 		return token.Token{}, err
 	}
+	if v == lquote {
+		// empty HexString
+		return token.Token{
+			Type:     token.HexString,
+			Value:    hex + lquote + v,
+			Position: start,
+		}, nil
+	}
 	if !isEvenHexDigits(v) {
 		return token.Token{}, token.NewPosError(vpos, "invalid HexString format")
 	}
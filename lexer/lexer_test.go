@@ -40,6 +40,18 @@ func TestLexer_Scan(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "There is a single quoted StringLiteral",
+			input: `'pragma'`,
+			wantToken: token.Token{
+				Type:  token.NonEmptyStringLiteral,
+				Value: `'pragma'`,
+				Position: token.Pos{
+					Column: 1,
+					Line:   1,
+				},
+			},
+		},
 		{
 			name:  "There is a StringLiteral",
 			input: `"pragma"`,
@@ -105,10 +117,8 @@ func TestLexer_Scan(t *testing.T) {
 		}
 		s := scanner.New(strings.NewReader(""))
 		l := Lexer{
-			scanner:   s,
-			peeked:    true,
-			peekToken: peekToken,
-			peekErr:   nil,
+			scanner: s,
+			peeked:  []peekResult{{token: peekToken, err: nil}},
 		}
 
 		tkn, err := l.Scan()
@@ -172,6 +182,47 @@ func TestLexer_Peek(t *testing.T) {
 	}
 }
 
+func TestLexer_PeekN(t *testing.T) {
+	l := New(strings.NewReader("uint memory x;"))
+
+	want := []token.Token{
+		{Type: token.Identifier, Value: "uint", Position: token.Pos{Column: 1, Line: 1}},
+		{Type: token.Memory, Value: "memory", Position: token.Pos{Column: 6, Line: 1}},
+		{Type: token.Identifier, Value: "x", Position: token.Pos{Column: 13, Line: 1}},
+		{Type: token.Semicolon, Value: ";", Position: token.Pos{Column: 14, Line: 1}},
+	}
+
+	tkn, err := l.PeekN(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[2], tkn); diff != "" {
+		t.Errorf(diff)
+	}
+
+	tkn, err = l.Peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(want[0], tkn); diff != "" {
+		t.Errorf(diff)
+	}
+
+	for _, w := range want {
+		tkn, err := l.Scan()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(w, tkn); diff != "" {
+			t.Errorf(diff)
+		}
+	}
+
+	if _, err := l.PeekN(0); err == nil {
+		t.Error("want error for n = 0")
+	}
+}
+
 func TestLexer_ScanStringLiteral(t *testing.T) {
 	tests := []struct {
 		input string
@@ -186,10 +237,10 @@ func TestLexer_ScanStringLiteral(t *testing.T) {
 			},
 		},
 		{
-			input: `\'Hello world!!\';`,
+			input: `'Hello world!!';`,
 			want: token.Token{
 				Type:     token.NonEmptyStringLiteral,
-				Value:    `\'Hello world!!\'`,
+				Value:    `'Hello world!!'`,
 				Position: token.Pos{Column: 1, Line: 1},
 			},
 		},
@@ -202,10 +253,10 @@ func TestLexer_ScanStringLiteral(t *testing.T) {
 			},
 		},
 		{
-			input: `\'\';`,
+			input: `'';`,
 			want: token.Token{
 				Type:     token.EmptyStringLiteral,
-				Value:    `\'\'`,
+				Value:    `''`,
 				Position: token.Pos{Column: 1, Line: 1},
 			},
 		},
@@ -238,7 +289,7 @@ func TestLexer_ScanStringLiteral(t *testing.T) {
 				Column: 1,
 				Line:   1,
 			},
-			Msg: `not found " or \'`,
+			Msg: `not found " or '`,
 		}
 
 		_, err := l.ScanStringLiteral()
@@ -259,21 +310,21 @@ func TestLexer_ScanUnicodeStringLiteral(t *testing.T) {
 			want:  tkn(token.UnicodeStringLiteral, `unicode"Hello 😃"`, pos(1, 1)),
 		},
 		{
-			input: `unicode\'Hello 😃\'`,
-			want:  tkn(token.UnicodeStringLiteral, `unicode\'Hello 😃\'`, pos(1, 1)),
+			input: `unicode'Hello 😃'`,
+			want:  tkn(token.UnicodeStringLiteral, `unicode'Hello 😃'`, pos(1, 1)),
 		},
 		{
 			input: `unicode Hello 😃`,
-			err:   perr(pos(8, 1), `not found " or \'`),
+			err:   perr(pos(8, 1), `not found " or '`),
 		},
 		{
 			input: `unicode "Hello 😃"`,
-			err:   perr(pos(8, 1), `not found " or \'`),
+			err:   perr(pos(8, 1), `not found " or '`),
 		},
 		{
 			input: `unicode
        "Hello 😃"`,
-			err: perr(pos(8, 1), `not found " or \'`),
+			err: perr(pos(8, 1), `not found " or '`),
 		},
 	}
 
@@ -289,8 +340,8 @@ func TestLexer_ScanHexStringLiteral(t *testing.T) {
 			want:  tkn(token.HexString, `hex"1B"`, pos(1, 1)),
 		},
 		{
-			input: `hex\'2E\'`,
-			want:  tkn(token.HexString, `hex\'2E\'`, pos(1, 1)),
+			input: `hex'2E'`,
+			want:  tkn(token.HexString, `hex'2E'`, pos(1, 1)),
 		},
 		{
 			input: `hex"16_3F_B5"`,
@@ -298,12 +349,12 @@ func TestLexer_ScanHexStringLiteral(t *testing.T) {
 		},
 		{
 			input: `hex "12"`,
-			err:   perr(pos(4, 1), `not found " or \'`),
+			err:   perr(pos(4, 1), `not found " or '`),
 		},
 		{
 			input: `hex
    "123"`,
-			err: perr(pos(4, 1), `not found " or \'`),
+			err: perr(pos(4, 1), `not found " or '`),
 		},
 		{
 			input: `hex" 123"`,
@@ -323,3 +374,149 @@ func TestLexer_ScanHexStringLiteral(t *testing.T) {
 		return l.ScanHexString()
 	})
 }
+
+func TestLexer_Scan_Number(t *testing.T) {
+	tests := TestData[token.Token]{
+		{input: "1_000;", want: tkn(token.Number, "1_000", pos(1, 1))},
+		{input: "0x00ff_ffff", want: tkn(token.Number, "0x00ff_ffff", pos(1, 1))},
+		{input: "1.5e3", want: tkn(token.Number, "1.5e3", pos(1, 1))},
+		{input: "2e-10", want: tkn(token.Number, "2e-10", pos(1, 1))},
+		{input: " .5", want: tkn(token.Number, ".5", pos(2, 1))},
+		{input: "0.8.13", err: perr(pos(1, 1), "invalid number literal.")},
+		{input: "1__0", err: perr(pos(1, 1), "invalid number literal.")},
+		{input: "0xfg", err: perr(pos(1, 1), "invalid number literal.")},
+		{input: "1.", err: perr(pos(1, 1), "invalid number literal.")},
+		{input: "1e", err: perr(pos(1, 1), "invalid number literal.")},
+		{input: "0x", err: perr(pos(1, 1), "invalid number literal.")},
+		{input: "0X1F", err: perr(pos(1, 1), "invalid number literal.")},
+		{input: " .5e", err: perr(pos(2, 1), "invalid number literal.")},
+	}
+
+	tests.Test(t, func(l *Lexer) (token.Token, error) {
+		return l.Scan()
+	})
+}
+
+func TestLexer_Scan_PragmaVersion(t *testing.T) {
+	l := New(strings.NewReader("pragma solidity ^0.8.13; 1__0"))
+
+	want := []token.Token{
+		{Type: token.Pragma, Value: "pragma", Position: token.Pos{Column: 1, Line: 1}},
+		{Type: token.Identifier, Value: "solidity", Position: token.Pos{Column: 8, Line: 1}},
+		{Type: token.BitXor, Value: "^", Position: token.Pos{Column: 17, Line: 1}},
+		{Type: token.Identifier, Value: "0.8.13", Position: token.Pos{Column: 18, Line: 1}},
+		{Type: token.Semicolon, Value: ";", Position: token.Pos{Column: 24, Line: 1}},
+	}
+
+	for _, w := range want {
+		tkn, err := l.Scan()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(w, tkn); diff != "" {
+			t.Errorf(diff)
+		}
+	}
+
+	// Versions are available only in pragma directives.
+	if _, err := l.Scan(); err == nil {
+		t.Error("want error for 1__0 after pragma directive")
+	}
+}
+
+func TestLexer_Scan_PrefixedString(t *testing.T) {
+	tests := TestData[token.Token]{
+		{input: `hex"00ff";`, want: tkn(token.HexString, `hex"00ff"`, pos(1, 1))},
+		{input: `hex"";`, want: tkn(token.HexString, `hex""`, pos(1, 1))},
+		{input: `unicode"😃";`, want: tkn(token.UnicodeStringLiteral, `unicode"😃"`, pos(1, 1))},
+		{input: `hex "00ff"`, want: tkn(token.Identifier, "hex", pos(1, 1))},
+		{input: `unicode;`, want: tkn(token.Identifier, "unicode", pos(1, 1))},
+		{input: `hex"0"`, err: perr(pos(5, 1), "invalid HexString format")},
+	}
+
+	tests.Test(t, func(l *Lexer) (token.Token, error) {
+		return l.Scan()
+	})
+}
+
+func TestLexer_ScanStringLiteral_Contents(t *testing.T) {
+	tests := TestData[token.Token]{
+		{input: `"a\"b"`, want: tkn(token.NonEmptyStringLiteral, `"a\"b"`, pos(1, 1))},
+		{input: `"\\"`, want: tkn(token.NonEmptyStringLiteral, `"\\"`, pos(1, 1))},
+		{input: `"\x41\u00e9\n"`, want: tkn(token.NonEmptyStringLiteral, `"\x41\u00e9\n"`, pos(1, 1))},
+		{input: "\"a\\\nb\"", want: tkn(token.NonEmptyStringLiteral, "\"a\\\nb\"", pos(1, 1))},
+		{input: `"a 'b' (c);"`, want: tkn(token.NonEmptyStringLiteral, `"a 'b' (c);"`, pos(1, 1))},
+		{input: `'it\'s "b"';`, want: tkn(token.NonEmptyStringLiteral, `'it\'s "b"'`, pos(1, 1))},
+		{input: `'\\';`, want: tkn(token.NonEmptyStringLiteral, `'\\'`, pos(1, 1))},
+		{input: `'abc"`, err: perr(pos(6, 1), "not found closing quote.")},
+		{input: "\"ab\tc\"", err: perr(pos(4, 1), "invalid character in string literal.")},
+		{input: `"é"`, err: perr(pos(2, 1), "invalid character in string literal.")},
+		{input: `"ab\q"`, err: perr(pos(4, 1), "invalid escape sequence.")},
+		{input: `"\x4g"`, err: perr(pos(2, 1), "invalid escape sequence.")},
+		{input: `"abc`, err: perr(pos(5, 1), "not found closing quote.")},
+	}
+
+	tests.Test(t, func(l *Lexer) (token.Token, error) {
+		return l.ScanStringLiteral()
+	})
+}
+
+func TestLexer_ScanUnicodeStringLiteral_Contents(t *testing.T) {
+	tests := TestData[token.Token]{
+		{input: `unicode"é\"é"`, want: tkn(token.UnicodeStringLiteral, `unicode"é\"é"`, pos(1, 1))},
+		{input: "unicode\"a\nb\"", err: perr(pos(10, 1), "invalid character in string literal.")},
+	}
+
+	tests.Test(t, func(l *Lexer) (token.Token, error) {
+		return l.ScanUnicodeStringLiteral()
+	})
+}
+
+func TestLexer_Scan_YulMode(t *testing.T) {
+	l := New(strings.NewReader("let x.slot := return(0, 32) -> leave a : b"))
+	if err := l.SetMode(YulMode); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []token.Token{
+		{Type: token.Let, Value: "let", Position: token.Pos{Column: 1, Line: 1}},
+		{Type: token.Identifier, Value: "x.slot", Position: token.Pos{Column: 5, Line: 1}},
+		{Type: token.YulAssign, Value: ":=", Position: token.Pos{Column: 12, Line: 1}},
+		{Type: token.Identifier, Value: "return", Position: token.Pos{Column: 15, Line: 1}},
+		{Type: token.LParen, Value: "(", Position: token.Pos{Column: 21, Line: 1}},
+		{Type: token.Number, Value: "0", Position: token.Pos{Column: 22, Line: 1}},
+		{Type: token.Comma, Value: ",", Position: token.Pos{Column: 23, Line: 1}},
+		{Type: token.Number, Value: "32", Position: token.Pos{Column: 25, Line: 1}},
+		{Type: token.RParen, Value: ")", Position: token.Pos{Column: 27, Line: 1}},
+		{Type: token.RightArrow, Value: "->", Position: token.Pos{Column: 29, Line: 1}},
+		{Type: token.Leave, Value: "leave", Position: token.Pos{Column: 32, Line: 1}},
+		{Type: token.Identifier, Value: "a", Position: token.Pos{Column: 38, Line: 1}},
+		{Type: token.Colon, Value: ":", Position: token.Pos{Column: 40, Line: 1}},
+		{Type: token.Identifier, Value: "b", Position: token.Pos{Column: 42, Line: 1}},
+	}
+
+	for _, w := range want {
+		tkn, err := l.Scan()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(w, tkn); diff != "" {
+			t.Errorf(diff)
+		}
+	}
+}
+
+func TestLexer_SetMode(t *testing.T) {
+	l := New(strings.NewReader("x.slot"))
+	if _, err := l.Peek(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.SetMode(YulMode); err == nil {
+		t.Error("want error while tokens are peeked")
+	}
+
+	l.Scan()
+	if err := l.SetMode(YulMode); err != nil {
+		t.Errorf("want no error, got: %s", err)
+	}
+}
@@ -6,15 +6,158 @@ import (
 )
 
 func (p *Parser) ParseLiteral() (ast.Literal, error) {
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tkn.Type {
+	case token.NonEmptyStringLiteral, token.EmptyStringLiteral:
+		return p.ParseStringLiteral()
+	case token.HexString:
+		return p.ParseHexStringLiteral()
+	case token.UnicodeStringLiteral:
+		return p.ParseUnicodeStringLiteral()
+	case token.Number:
+		return p.ParseNumberLiteral()
+	case token.TrueLiteral, token.FalseLiteral:
+		return p.ParseBooleanLiteral()
+	}
+
+	return nil, token.NewPosError(tkn.Position, "not found literal.")
+}
+
+func isStringLiteral(tkn token.Token) bool {
+	return tkn.Type == token.NonEmptyStringLiteral || tkn.Type == token.EmptyStringLiteral
+}
+
+// ParseStringLiteral parses string-literal.
+// If adjacent string literals are found, they are concatenated into ConcatenatedStringLiteral.
+func (p *Parser) ParseStringLiteral() (ast.Literal, error) {
 	tkn, err := p.lexer.Scan()
 	if err != nil {
 		return nil, err
 	}
 
-	if tkn.Type != token.NonEmptyStringLiteral {
+	if !isStringLiteral(tkn) {
 		return nil, token.NewPosError(tkn.Position, "not found string literal quote")
 	}
 
 	lit := ast.StringLiteral(tkn)
-	return &lit, nil
+	lits := ast.ConcatenatedStringLiteral{&lit}
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if !isStringLiteral(tkn) {
+			break
+		}
+		p.lexer.Scan()
+
+		lit := ast.StringLiteral(tkn)
+		lits = append(lits, &lit)
+	}
+
+	if len(lits) == 1 {
+		return lits[0], nil
+	}
+	return &lits, nil
+}
+
+func (p *Parser) ParseHexStringLiteral() (*ast.HexStringLiteral, error) {
+	tkn, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+
+	if tkn.Type != token.HexString {
+		return nil, token.NewPosError(tkn.Position, "not found hex string.")
+	}
+
+	hs := ast.HexString(tkn)
+	lit := ast.HexStringLiteral{&hs}
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type != token.HexString {
+			return &lit, nil
+		}
+		p.lexer.Scan()
+
+		hs := ast.HexString(tkn)
+		lit = append(lit, &hs)
+	}
+}
+
+func (p *Parser) ParseUnicodeStringLiteral() (*ast.UnicordStringLiteral, error) {
+	tkn, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+
+	if tkn.Type != token.UnicodeStringLiteral {
+		return nil, token.NewPosError(tkn.Position, "not found unicode string literal.")
+	}
+
+	us := ast.UnicordStrings(tkn)
+	lit := ast.UnicordStringLiteral{&us}
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type != token.UnicodeStringLiteral {
+			return &lit, nil
+		}
+		p.lexer.Scan()
+
+		us := ast.UnicordStrings(tkn)
+		lit = append(lit, &us)
+	}
+}
+
+func isNumberUnit(tkn token.Token) bool {
+	if tkn.Type != token.Identifier {
+		return false
+	}
+	switch tkn.Value {
+	case "wei", "gwei", "ether", "seconds", "minutes", "hours", "days", "weeks", "years":
+		return true
+	}
+	return false
+}
+
+func (p *Parser) ParseNumberLiteral() (*ast.NumberLiteral, error) {
+	num, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if num.Type != token.Number {
+		return nil, token.NewPosError(num.Position, "not found number.")
+	}
+	// the lexer accepts only well-formed numbers, so the value is nil only if the exponent is too large. (e.g. 1e100000000)
+	if (ast.NumberLiteral{Number: num}).Rat() == nil {
+		return nil, token.NewPosError(num.Position, "number exponent is too large.")
+	}
+
+	unit, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if !isNumberUnit(unit) {
+		return &ast.NumberLiteral{Number: num}, nil
+	}
+	if len(num.Value) > 1 && num.Value[:2] == "0x" {
+		return nil, token.NewPosError(unit.Position, "number unit is not available for hex number.")
+	}
+	p.lexer.Scan()
+
+	nu := ast.NumberUnit(unit)
+	return &ast.NumberLiteral{
+		Number:     num,
+		NumberUnit: &nu,
+	}, nil
 }
@@ -30,9 +30,14 @@ func TestParser_ParseLiteral(t *testing.T) {
 		{
 			name:  `Including \n`,
 			input: "\"Hello \nWorld!!\";",
+			err:   perr(pos(8, 1), "invalid character in string literal."),
+		},
+		{
+			name:  `Including \n after line continuation`,
+			input: "\"Hello \\\nWorld!!\";",
 			want: &ast.StringLiteral{
 				Type:     token.NonEmptyStringLiteral,
-				Value:    "\"Hello \nWorld!!\"",
+				Value:    "\"Hello \\\nWorld!!\"",
 				Position: pos(1, 1),
 			},
 		},
@@ -52,6 +57,20 @@ func TestParser_ParseLiteral(t *testing.T) {
 		// 	},
 		// 	err: nil,
 		// },
+		{
+			name:  "NumberLiteral",
+			input: "1e18;",
+			want: &ast.NumberLiteral{
+				Number: tkn(token.Number, "1e18", pos(1, 1)),
+			},
+		},
+		{
+			name:  "BooleanLiteral",
+			input: "true;",
+			want: &ast.BooleanLiteral{
+				Token: tkn(token.TrueLiteral, "true", pos(1, 1)),
+			},
+		},
 		{
 			name:  "Not Literal",
 			input: "pragma",
@@ -60,7 +79,7 @@ func TestParser_ParseLiteral(t *testing.T) {
 					Column: 1,
 					Line:   1,
 				},
-				Msg: "not found string literal quote",
+				Msg: "not found literal.",
 			},
 		},
 	}
@@ -85,3 +104,108 @@ func TestParser_ParseLiteral(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_ParseNumberLiteral(t *testing.T) {
+	tests := TestData[*ast.NumberLiteral]{
+		{
+			input: "1_000",
+			want: &ast.NumberLiteral{
+				Number: tkn(token.Number, "1_000", pos(1, 1)),
+			},
+		},
+		{
+			input: "0xff + 1",
+			want: &ast.NumberLiteral{
+				Number: tkn(token.Number, "0xff", pos(1, 1)),
+			},
+		},
+		{
+			input: "1.5 ether",
+			want: &ast.NumberLiteral{
+				Number: tkn(token.Number, "1.5", pos(1, 1)),
+				NumberUnit: &ast.NumberUnit{
+					Type:     token.Identifier,
+					Value:    "ether",
+					Position: pos(5, 1),
+				},
+			},
+		},
+		{
+			input: "2 days;",
+			want: &ast.NumberLiteral{
+				Number: tkn(token.Number, "2", pos(1, 1)),
+				NumberUnit: &ast.NumberUnit{
+					Type:     token.Identifier,
+					Value:    "days",
+					Position: pos(3, 1),
+				},
+			},
+		},
+		{
+			input: "0x10 wei",
+			err:   perr(pos(6, 1), "number unit is not available for hex number."),
+		},
+		{
+			input: "wei",
+			err:   perr(pos(1, 1), "not found number."),
+		},
+		{
+			input: "1e100000000",
+			err:   perr(pos(1, 1), "number exponent is too large."),
+		},
+		{
+			input: "1e-100000000",
+			err:   perr(pos(1, 1), "number exponent is too large."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.NumberLiteral, error) {
+		return p.ParseNumberLiteral()
+	})
+}
+
+func TestParser_ParseLiteral_Strings(t *testing.T) {
+	tests := TestData[ast.Literal]{
+		{
+			input: `"";`,
+			want: &ast.StringLiteral{
+				Type:     token.EmptyStringLiteral,
+				Value:    `""`,
+				Position: pos(1, 1),
+			},
+		},
+		{
+			input: `"a" "b"
+  "c";`,
+			want: &ast.ConcatenatedStringLiteral{
+				{Type: token.NonEmptyStringLiteral, Value: `"a"`, Position: pos(1, 1)},
+				{Type: token.NonEmptyStringLiteral, Value: `"b"`, Position: pos(5, 1)},
+				{Type: token.NonEmptyStringLiteral, Value: `"c"`, Position: pos(3, 2)},
+			},
+		},
+		{
+			input: `hex"00ff";`,
+			want: &ast.HexStringLiteral{
+				{Type: token.HexString, Value: `hex"00ff"`, Position: pos(1, 1)},
+			},
+		},
+		{
+			input: `hex"00" hex"11";`,
+			want: &ast.HexStringLiteral{
+				{Type: token.HexString, Value: `hex"00"`, Position: pos(1, 1)},
+				{Type: token.HexString, Value: `hex"11"`, Position: pos(9, 1)},
+			},
+		},
+		{
+			input: `unicode"😃" unicode"!";`,
+			want: &ast.UnicordStringLiteral{
+				{Type: token.UnicodeStringLiteral, Value: `unicode"😃"`, Position: pos(1, 1)},
+				{Type: token.UnicodeStringLiteral, Value: `unicode"!"`, Position: pos(12, 1)},
+			},
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.Literal, error) {
+		return p.ParseLiteral()
+	})
+}
@@ -0,0 +1,71 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+// modifier definitions can only be specified virtual and override.
+func isModifierDefinitionModifier(e ast.ModifierListElement) bool {
+	switch e.(type) {
+	case *ast.Virtual, *ast.OverrideSpecifier:
+		return true
+	}
+	return false
+}
+
+func (p *Parser) ParseModifierDefinition() (*ast.ModifierDefinition, error) {
+	mod, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if mod.Type != token.Modifier {
+		return nil, token.NewPosError(mod.Position, "not found modifier keyword.")
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var lparen, rparen *token.Pos
+	var prms ast.ParameterList
+	if tkn.Type == token.LParen {
+		lp, pl, rp, err := p.parseParameters()
+		if err != nil {
+			return nil, err
+		}
+		lparen, prms, rparen = &lp, pl, &rp
+	}
+
+	ml, err := p.ParseModifierList()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkModifierList(ml, isModifierDefinitionModifier, "invalid modifier definition modifier."); err != nil {
+		return nil, err
+	}
+
+	p.inModifier = true
+	b, semi, err := p.parseFunctionBody()
+	p.inModifier = false
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.ModifierDefinition{
+		Modifier:     mod.Position,
+		Identifier:   id,
+		LParen:       lparen,
+		Parameters:   prms,
+		RParen:       rparen,
+		ModifierList: ml,
+		Block:        b,
+		Semicolon:    semi,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseModifierDefinition(t *testing.T) {
+	tests := TestData[*ast.ModifierDefinition]{
+		{
+			input: "modifier onlyOwner() { require(msg.sender == owner); _; }",
+			want: &ast.ModifierDefinition{
+				Modifier:     pos(1, 1),
+				Identifier:   *identPtr("onlyOwner", pos(10, 1)),
+				LParen:       posPtr(19, 1),
+				RParen:       posPtr(20, 1),
+				ModifierList: &ast.ModifierList{},
+				Block: &ast.Block{
+					LBracePos: pos(22, 1),
+					Nodes: []ast.Statement{
+						&ast.ExpressionStatement{
+							Expression: &ast.FunctionCall{
+								Expression: identPtr("require", pos(24, 1)),
+								CallArgumentList: &ast.CallArgumentList{
+									LParen: pos(31, 1),
+									Elements: ast.CallArgumentListExpretions{
+										{
+											Expression: &ast.BinaryExpression{
+												Left: &ast.MemberAccess{
+													Expression: identPtr("msg", pos(32, 1)),
+													Period:     pos(35, 1),
+													Member:     *identPtr("sender", pos(36, 1)),
+												},
+												Operator: tkn(token.Equal, "==", pos(43, 1)),
+												Right:    identPtr("owner", pos(46, 1)),
+											},
+										},
+									},
+									RParen: pos(51, 1),
+								},
+							},
+							Semicolon: pos(52, 1),
+						},
+						&ast.PlaceholderStatement{
+							Placeholder: pos(54, 1),
+							Semicolon:   pos(55, 1),
+						},
+					},
+					RBracePos: pos(57, 1),
+				},
+			},
+		},
+		{
+			input: "modifier m(bool b) { if (b) { _; } }",
+			want: &ast.ModifierDefinition{
+				Modifier:   pos(1, 1),
+				Identifier: *identPtr("m", pos(10, 1)),
+				LParen:     posPtr(11, 1),
+				Parameters: ast.ParameterList{
+					{
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(12, 1)), Kind: ast.ElementaryTypeNameKindBool},
+						Identifier: identPtr("b", pos(17, 1)),
+					},
+				},
+				RParen:       posPtr(18, 1),
+				ModifierList: &ast.ModifierList{},
+				Block: &ast.Block{
+					LBracePos: pos(20, 1),
+					Nodes: []ast.Statement{
+						&ast.IfStatement{
+							If:        pos(22, 1),
+							LParen:    pos(25, 1),
+							Condition: identPtr("b", pos(26, 1)),
+							RParen:    pos(27, 1),
+							TrueBody: &ast.Block{
+								LBracePos: pos(29, 1),
+								Nodes: []ast.Statement{
+									&ast.PlaceholderStatement{
+										Placeholder: pos(31, 1),
+										Semicolon:   pos(32, 1),
+									},
+								},
+								RBracePos: pos(34, 1),
+							},
+						},
+					},
+					RBracePos: pos(36, 1),
+				},
+			},
+		},
+		{
+			input: "modifier m virtual override;",
+			want: &ast.ModifierDefinition{
+				Modifier:   pos(1, 1),
+				Identifier: *identPtr("m", pos(10, 1)),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Virtual{Virtual: pos(12, 1)},
+						&ast.OverrideSpecifier{Override: pos(20, 1)},
+					},
+				},
+				Semicolon: posPtr(28, 1),
+			},
+		},
+		{
+			input: "function m() {}",
+			err:   perr(pos(1, 1), "not found modifier keyword."),
+		},
+		{
+			input: "modifier m() public {}",
+			err:   perr(pos(14, 1), "invalid modifier definition modifier."),
+		},
+		{
+			input: "modifier m() { _ }",
+			err:   perr(pos(18, 1), "not found semicolon."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.ModifierDefinition, error) {
+		return p.ParseModifierDefinition()
+	})
+}
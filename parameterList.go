@@ -11,9 +11,36 @@ func (p *Parser) ParseParameter() (*ast.Parameter, error) {
 		return nil, err
 	}
 
-	return &ast.Parameter{
+	prm := &ast.Parameter{
 		TypeName: tn,
-	}, nil
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if isDataLocation(tkn.Type) {
+		dl, err := p.ParseDataLocation()
+		if err != nil {
+			return nil, err
+		}
+		prm.DataLocation = &dl
+
+		tkn, err = p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if isIdentifier(tkn) {
+		id, err := p.ParseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		prm.Identifier = &id
+	}
+
+	return prm, nil
 }
 
 func (p *Parser) ParseParameterList() (ast.ParameterList, error) {
@@ -36,5 +63,6 @@ func (p *Parser) ParseParameterList() (ast.ParameterList, error) {
 		}
 
 		p.lexer.Scan()
+		prm.Comma = &comma.Position
 	}
 }
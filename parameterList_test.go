@@ -23,11 +23,12 @@ func TestParser_ParseParameter(t *testing.T) {
 			input: "string",
 			want: &ast.Parameter{
 				TypeName: ast.ElementaryTypeName{
-					{
+					Token: token.Token{
 						Type:     token.String,
 						Value:    "string",
 						Position: token.Pos{Column: 1, Line: 1},
 					},
+					Kind: ast.ElementaryTypeNameKindString,
 				},
 			},
 			err: nil,
@@ -79,11 +80,12 @@ func TestParser_ParseParameterList(t *testing.T) {
 			want: ast.ParameterList{
 				{
 					TypeName: ast.ElementaryTypeName{
-						{
+						Token: token.Token{
 							Type:     token.String,
 							Value:    "string",
 							Position: token.Pos{Column: 1, Line: 1},
 						},
+						Kind: ast.ElementaryTypeNameKindString,
 					},
 				},
 			},
@@ -95,20 +97,23 @@ func TestParser_ParseParameterList(t *testing.T) {
 			want: ast.ParameterList{
 				{
 					TypeName: ast.ElementaryTypeName{
-						{
+						Token: token.Token{
 							Type:     token.String,
 							Value:    "string",
 							Position: token.Pos{Column: 1, Line: 1},
 						},
+						Kind: ast.ElementaryTypeNameKindString,
 					},
+					Comma: &token.Pos{Column: 7, Line: 1},
 				},
 				{
 					TypeName: ast.ElementaryTypeName{
-						{
+						Token: token.Token{
 							Type:     token.Bool,
 							Value:    "bool",
 							Position: token.Pos{Column: 9, Line: 1},
 						},
+						Kind: ast.ElementaryTypeNameKindBool,
 					},
 				},
 			},
@@ -32,7 +32,7 @@ func New(reader io.Reader) *Scanner {
 
 func isOperatorRune(r rune) bool {
 	switch r {
-	case '(', ')', '[', ']', '{', '}', ':', ';', '?', '=', '|', '^', '&', '<', '>', '+', '-', '*', '/', '%', ',', '!', '~', '"', '\'', '\\':
+	case '(', ')', '[', ']', '{', '}', ':', ';', '.', '?', '=', '|', '^', '&', '<', '>', '+', '-', '*', '/', '%', ',', '!', '~', '"', '\'', '\\':
 		return true
 	}
 	return false
@@ -75,7 +75,7 @@ func (s *Scanner) scanOperator() (string, error) {
 	}
 	oprt := string([]rune{ch1, ch2})
 	switch oprt {
-	case "=>", "->", "|=", "^=", "&=", "+=", "-=", "*=", "/=", "%=", "==", "||", "&&", "**", "!=", "<-", ">-", "++", "--", `\'`:
+	case "=>", "->", "|=", "^=", "&=", "+=", "-=", "*=", "/=", "%=", "==", "||", "&&", "**", "!=", "<=", ">=", "++", "--":
 		if _, err := s.readRune(); err != nil {
 			return "", err
 		}
@@ -136,6 +136,10 @@ func (s *Scanner) scanOperator() (string, error) {
 // - If first rune is operator character, scan operator.
 // - If first rune is a space character, scan until the end of the blank character.
 // - Else scan to next space or operator string.
+//
+// When the first rune is a decimal digit, '.' is not treated as an operator (e.g. 1.5, 0.8.13),
+// and '-' following an exponent is included (e.g. 1e-5).
+// '.' followed by a decimal digit is also scanned as a number (e.g. .5).
 func (s *Scanner) scan() (token.Pos, string, error) {
 	startPos := token.Pos{
 		Column: s.offset + 1,
@@ -149,6 +153,16 @@ func (s *Scanner) scan() (token.Pos, string, error) {
 	if ch == bufrr.EOF {
 		return startPos, token.EOSString, nil
 	}
+	if ch == '.' {
+		if _, err := s.readRune(); err != nil {
+			return token.Pos{}, "", err
+		}
+		ch2, _, err := s.r.PeekRune()
+		if err != nil || !isDecimalDigit(ch2) {
+			return startPos, ".", nil
+		}
+		return s.scanRest(startPos, ch)
+	}
 	if isOperatorRune(ch) {
 		// scan operator.
 		oprt, err := s.scanOperator()
@@ -162,7 +176,17 @@ func (s *Scanner) scan() (token.Pos, string, error) {
 		return token.Pos{}, "", err
 	}
 
+	return s.scanRest(startPos, ch)
+}
+
+func isDecimalDigit(r rune) bool {
+	return '0' <= r && r <= '9'
+}
+
+// scanRest scans the rest of the string whose first rune is ch.
+func (s *Scanner) scanRest(startPos token.Pos, ch rune) (token.Pos, string, error) {
 	readingSpace := token.IsSpace(ch)
+	readingNumber := isDecimalDigit(ch) || ch == '.'
 	rslt := []rune{ch}
 
 	for {
@@ -170,6 +194,13 @@ func (s *Scanner) scan() (token.Pos, string, error) {
 		if err != nil {
 			return token.Pos{}, "", err
 		}
+		if readingNumber && (ch == '.' || ch == '-' && isExponentPrefix(rslt)) {
+			if _, err = s.readRune(); err != nil {
+				return token.Pos{}, "", err
+			}
+			rslt = append(rslt, ch)
+			continue
+		}
 		if token.IsSpace(ch) != readingSpace || isOperatorRune(ch) || ch == bufrr.EOF {
 			return startPos, string(rslt), nil
 		}
@@ -180,6 +211,15 @@ func (s *Scanner) scan() (token.Pos, string, error) {
 	}
 }
 
+// isExponentPrefix reports whether number ends with the exponent mark of a decimal number.
+func isExponentPrefix(number []rune) bool {
+	if len(number) >= 2 && number[0] == '0' && (number[1] == 'x' || number[1] == 'X') {
+		return false
+	}
+	last := number[len(number)-1]
+	return last == 'e' || last == 'E'
+}
+
 // Scan divides a string into the smallest units from the bufrr.Reader and returns them one by one.
 func (s *Scanner) Scan() (token.Pos, string, error) {
 	if s.peeked {
@@ -205,3 +245,40 @@ func (s *Scanner) Peek() (pos token.Pos, str string, err error) {
 	s.peekErr = err
 	return
 }
+
+var (
+	errPeeked = errors.New("Scanned string is peeked.")
+)
+
+// ScanRune reads a rune without dividing into strings.
+// It is used to read the contents of string literals, and is not available while a string is peeked.
+// bufrr.EOF is returned at the end of the source.
+func (s *Scanner) ScanRune() (token.Pos, rune, error) {
+	if s.peeked {
+		return token.Pos{}, invalidRune, errPeeked
+	}
+
+	pos := token.Pos{
+		Column: s.offset + 1,
+		Line:   s.lineOffset + 1,
+	}
+	r, err := s.readRune()
+	if err != nil {
+		return token.Pos{}, invalidRune, err
+	}
+	return pos, r, nil
+}
+
+// PeekRune reads ahead and returns the result of ScanRune.
+// The offset is unchanged.
+func (s *Scanner) PeekRune() (rune, error) {
+	if s.peeked {
+		return invalidRune, errPeeked
+	}
+
+	r, _, err := s.r.PeekRune()
+	if err != nil {
+		return invalidRune, err
+	}
+	return r, nil
+}
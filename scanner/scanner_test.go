@@ -53,26 +53,66 @@ func TestScanner_Scan(t *testing.T) {
 				"solidity",
 			},
 		},
+		{
+			name:  "there are periods",
+			input: "msg.data 1.5",
+			wantPoss: []token.Pos{
+				{Column: 1, Line: 1},
+				{Column: 4, Line: 1},
+				{Column: 5, Line: 1},
+				{Column: 9, Line: 1},
+				{Column: 10, Line: 1},
+			},
+			wantStrs: []string{
+				"msg",
+				".",
+				"data",
+				" ",
+				"1.5",
+			},
+		},
+		{
+			name:  "there are numbers",
+			input: "1e-5 .5-0x1e-5",
+			wantPoss: []token.Pos{
+				{Column: 1, Line: 1},
+				{Column: 5, Line: 1},
+				{Column: 6, Line: 1},
+				{Column: 8, Line: 1},
+				{Column: 9, Line: 1},
+				{Column: 13, Line: 1},
+				{Column: 14, Line: 1},
+			},
+			wantStrs: []string{
+				"1e-5",
+				" ",
+				".5",
+				"-",
+				"0x1e",
+				"-",
+				"5",
+			},
+		},
 		{
 			name:  "there are operators",
-			input: `a >> \'test\'`,
+			input: `a >> 'test'`,
 			wantPoss: []token.Pos{
 				{Column: 1, Line: 1},
 				{Column: 2, Line: 1},
 				{Column: 3, Line: 1},
 				{Column: 5, Line: 1},
 				{Column: 6, Line: 1},
-				{Column: 8, Line: 1},
-				{Column: 12, Line: 1},
+				{Column: 7, Line: 1},
+				{Column: 11, Line: 1},
 			},
 			wantStrs: []string{
 				"a",
 				" ",
 				">>",
 				" ",
-				`\'`,
+				`'`,
 				"test",
-				`\'`,
+				`'`,
 			},
 		},
 	}
@@ -211,6 +251,9 @@ func TestScanner_scanOperator(t *testing.T) {
 		{input: "^0.8.13", want: "^"},
 		{input: "=>>", want: "=>"},
 		{input: "<< ", want: "<<"},
+		{input: "<=a", want: "<="},
+		{input: ">=1", want: ">="},
+		{input: "<-1", want: "<"},
 		{input: "<<=a", want: "<<="},
 		{input: ">>1", want: ">>"},
 		{input: ">>=x", want: ">>="},
@@ -238,3 +281,52 @@ func TestScanner_scanOperator(t *testing.T) {
 		})
 	}
 }
+
+func TestScanner_ScanRune(t *testing.T) {
+	s := New(strings.NewReader("\"a\n😃"))
+
+	if _, _, err := s.Scan(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantPoss := []token.Pos{
+		{Column: 2, Line: 1},
+		{Column: 3, Line: 1},
+		{Column: 1, Line: 2},
+		{Column: 2, Line: 2},
+	}
+	wantRunes := []rune{'a', '\n', '😃', bufrr.EOF}
+	for i := range wantRunes {
+		r, err := s.PeekRune()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r != wantRunes[i] {
+			t.Errorf("peeked %q, want %q", r, wantRunes[i])
+		}
+
+		pos, r, err := s.ScanRune()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(wantPoss[i], pos); diff != "" {
+			t.Errorf(diff)
+		}
+		if r != wantRunes[i] {
+			t.Errorf("got %q, want %q", r, wantRunes[i])
+		}
+	}
+
+	t.Run("when peeked", func(t *testing.T) {
+		s := New(strings.NewReader("a"))
+		if _, _, err := s.Peek(); err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err := s.ScanRune(); !errors.Is(err, errPeeked) {
+			t.Errorf("got unexpected error: %s", err)
+		}
+		if _, err := s.PeekRune(); !errors.Is(err, errPeeked) {
+			t.Errorf("got unexpected error: %s", err)
+		}
+	})
+}
@@ -12,6 +12,10 @@ import (
 type Parser struct {
 	input io.Reader
 	lexer *lexer.Lexer
+
+	// inModifier reports whether the parser is in the body of a modifier definition,
+	// where "_;" is a placeholder statement.
+	inModifier bool
 }
 
 func New(input io.Reader) *Parser {
@@ -24,8 +28,14 @@ func New(input io.Reader) *Parser {
 func (p *Parser) Parse() (*ast.SourceUnit, error) {
 	var pragmaDirective *ast.PragmaDirective
 	var importDirective *ast.ImportDirective
-	var contractDefinition *ast.ContractDefinition
 	var functionDefinition *ast.FunctionDefinition
+	var contractDefinitions []*ast.ContractDefinition
+	var structDefinitions []*ast.StructDefinition
+	var enumDefinitions []*ast.EnumDefinition
+	var userDefinedValueTypeDefinitions []*ast.UserDefinedValueTypeDefinition
+	var eventDefinitions []*ast.EventDefinition
+	var errorDefinitions []*ast.ErrorDefinition
+	var usingDirectives []*ast.UsingDirective
 	for {
 		tkn, err := p.lexer.Peek()
 		if err != nil {
@@ -45,24 +55,67 @@ func (p *Parser) Parse() (*ast.SourceUnit, error) {
 				return nil, err
 			}
 			importDirective = imp
-		case token.Abstract, token.Contract:
+		case token.Abstract, token.Contract, token.Interface, token.Library:
 			cntrct, err := p.ParseContractDefinition()
 			if err != nil {
 				return nil, err
 			}
-			contractDefinition = cntrct
+			contractDefinitions = append(contractDefinitions, cntrct)
 		case token.Function:
 			fnc, err := p.ParseFunctionDefinition()
 			if err != nil {
 				return nil, err
 			}
 			functionDefinition = fnc
+		case token.Struct:
+			st, err := p.ParseStructDefinition()
+			if err != nil {
+				return nil, err
+			}
+			structDefinitions = append(structDefinitions, st)
+		case token.Enum:
+			enm, err := p.ParseEnumDefinition()
+			if err != nil {
+				return nil, err
+			}
+			enumDefinitions = append(enumDefinitions, enm)
+		case token.Type:
+			udvt, err := p.ParseUserDefinedValueTypeDefinition()
+			if err != nil {
+				return nil, err
+			}
+			userDefinedValueTypeDefinitions = append(userDefinedValueTypeDefinitions, udvt)
+		case token.Event:
+			evt, err := p.ParseEventDefinition()
+			if err != nil {
+				return nil, err
+			}
+			eventDefinitions = append(eventDefinitions, evt)
+		case token.Error:
+			e, err := p.ParseErrorDefinition()
+			if err != nil {
+				return nil, err
+			}
+			errorDefinitions = append(errorDefinitions, e)
+		case token.Using:
+			ud, err := p.ParseUsingDirective()
+			if err != nil {
+				return nil, err
+			}
+			usingDirectives = append(usingDirectives, ud)
 		case token.EOS:
 			return &ast.SourceUnit{
 				PragmaDirective:    pragmaDirective,
 				ImportDirective:    importDirective,
-				ContractDefinition: contractDefinition,
 				FunctionDefinition: functionDefinition,
+
+				ContractDefinitions:             contractDefinitions,
+				StructDefinitions:               structDefinitions,
+				EnumDefinitions:                 enumDefinitions,
+				UserDefinedValueTypeDefinitions: userDefinedValueTypeDefinitions,
+				EventDefinitions:                eventDefinitions,
+				ErrorDefinitions:                errorDefinitions,
+				UsingDirectives:                 usingDirectives,
 			}, nil
 		default:
 			return nil, token.NewPosError(tkn.Position, "invalid")
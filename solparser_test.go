@@ -49,70 +49,75 @@ contract HelloWorld {
 					},
 					Semicolon: token.Pos{Column: 24, Line: 1},
 				},
-				ContractDefinition: &ast.ContractDefinition{
-					Contract: token.Pos{Column: 1, Line: 3},
-					Identifier: ast.Identifier{
-						Type:     token.Identifier,
-						Value:    "HelloWorld",
-						Position: token.Pos{Column: 10, Line: 3},
-					},
-					LBrace: token.Pos{Column: 21, Line: 3},
-					ContractBodyElements: []ast.ContractBodyElement{
-						&ast.FunctionDefinition{
-							From: token.Pos{Column: 5, Line: 4},
-							FunctionDescriptor: token.Token{
-								Type:     token.Identifier,
-								Value:    "hello",
-								Position: token.Pos{Column: 14, Line: 4},
-							},
-							LParen: token.Pos{Column: 19, Line: 4},
-							RParen: token.Pos{Column: 20, Line: 4},
-							ModifierList: &ast.ModifierList{
-								Visibility: &token.Token{
-									Type:     token.Public,
-									Value:    "public",
-									Position: token.Pos{Column: 22, Line: 4},
+				ContractDefinitions: []*ast.ContractDefinition{
+					{
+						Contract: token.Pos{Column: 1, Line: 3},
+						Identifier: ast.Identifier{
+							Type:     token.Identifier,
+							Value:    "HelloWorld",
+							Position: token.Pos{Column: 10, Line: 3},
+						},
+						LBrace: token.Pos{Column: 21, Line: 3},
+						ContractBodyElements: []ast.ContractBodyElement{
+							&ast.FunctionDefinition{
+								From: token.Pos{Column: 5, Line: 4},
+								FunctionDescriptor: token.Token{
+									Type:     token.Identifier,
+									Value:    "hello",
+									Position: token.Pos{Column: 14, Line: 4},
 								},
-								StateMutability: &token.Token{
-									Type:     token.Pure,
-									Value:    "pure",
-									Position: token.Pos{Column: 29, Line: 4},
+								LParen: token.Pos{Column: 19, Line: 4},
+								RParen: token.Pos{Column: 20, Line: 4},
+								ModifierList: &ast.ModifierList{
+									Elements: []ast.ModifierListElement{
+										&ast.Visibility{
+											Type:     token.Public,
+											Value:    "public",
+											Position: token.Pos{Column: 22, Line: 4},
+										},
+										&ast.StateMutability{
+											Type:     token.Pure,
+											Value:    "pure",
+											Position: token.Pos{Column: 29, Line: 4},
+										},
+									},
 								},
-							},
-							Returns: &ast.FunctionDefinitionReturns{
-								From:   token.Pos{Column: 34, Line: 4},
-								LParen: token.Pos{Column: 42, Line: 4},
-								ParameterList: []*ast.Parameter{
-									{
-										TypeName: ast.ElementaryTypeName{
-											{
-												Type:     token.String,
-												Value:    "string",
-												Position: token.Pos{Column: 43, Line: 4},
+								Returns: &ast.FunctionDefinitionReturns{
+									From:   token.Pos{Column: 34, Line: 4},
+									LParen: token.Pos{Column: 42, Line: 4},
+									ParameterList: []*ast.Parameter{
+										{
+											TypeName: ast.ElementaryTypeName{
+												Token: token.Token{
+													Type:     token.String,
+													Value:    "string",
+													Position: token.Pos{Column: 43, Line: 4},
+												},
+												Kind: ast.ElementaryTypeNameKindString,
 											},
 										},
 									},
+									RParen: token.Pos{Column: 49, Line: 4},
 								},
-								RParen: token.Pos{Column: 49, Line: 4},
-							},
-							Block: &ast.Block{
-								LBracePos: token.Pos{Column: 51, Line: 4},
-								RBracePos: token.Pos{Column: 5, Line: 6},
-								Nodes: []ast.Node{
-									&ast.ReturnStatement{
-										From:    token.Pos{Column: 9, Line: 5},
-										SemiPos: token.Pos{Column: 31, Line: 5},
-										Expression: &ast.StringLiteral{
-											Type:     token.NonEmptyStringLiteral,
-											Position: token.Pos{Column: 16, Line: 5},
-											Value:    "\"Hello World!!\"",
+								Block: &ast.Block{
+									LBracePos: token.Pos{Column: 51, Line: 4},
+									RBracePos: token.Pos{Column: 5, Line: 6},
+									Nodes: []ast.Statement{
+										&ast.ReturnStatement{
+											From:    token.Pos{Column: 9, Line: 5},
+											SemiPos: token.Pos{Column: 31, Line: 5},
+											Expression: &ast.StringLiteral{
+												Type:     token.NonEmptyStringLiteral,
+												Position: token.Pos{Column: 16, Line: 5},
+												Value:    "\"Hello World!!\"",
+											},
 										},
 									},
 								},
 							},
 						},
+						RBrace: token.Pos{Column: 1, Line: 7},
 					},
-					RBrace: token.Pos{Column: 1, Line: 7},
 				},
 			},
 		},
@@ -210,3 +215,170 @@ func TestParser_ParseBooleanLiteral(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_Parse_Definitions(t *testing.T) {
+	tests := TestData[*ast.SourceUnit]{
+		{
+			input: `struct P { bool x; }
+enum E { A }
+type T is bool;`,
+			want: &ast.SourceUnit{
+				StructDefinitions: []*ast.StructDefinition{
+					{
+						Struct:     pos(1, 1),
+						Identifier: *identPtr("P", pos(8, 1)),
+						LBrace:     pos(10, 1),
+						Members: []*ast.StructMember{
+							{
+								TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(12, 1)), Kind: ast.ElementaryTypeNameKindBool},
+								Identifier: *identPtr("x", pos(17, 1)),
+								Semicolon:  pos(18, 1),
+							},
+						},
+						RBrace: pos(20, 1),
+					},
+				},
+				EnumDefinitions: []*ast.EnumDefinition{
+					{
+						Enum:       pos(1, 2),
+						Identifier: *identPtr("E", pos(6, 2)),
+						LBrace:     pos(8, 2),
+						Values: []*ast.EnumValue{
+							{Identifier: *identPtr("A", pos(10, 2))},
+						},
+						RBrace: pos(12, 2),
+					},
+				},
+				UserDefinedValueTypeDefinitions: []*ast.UserDefinedValueTypeDefinition{
+					{
+						Type:       pos(1, 3),
+						Identifier: *identPtr("T", pos(6, 3)),
+						Is:         pos(8, 3),
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(11, 3)), Kind: ast.ElementaryTypeNameKindBool},
+						Semicolon:  pos(15, 3),
+					},
+				},
+			},
+		},
+		{
+			input: `event E();
+error X();`,
+			want: &ast.SourceUnit{
+				EventDefinitions: []*ast.EventDefinition{
+					{
+						Event:      pos(1, 1),
+						Identifier: *identPtr("E", pos(7, 1)),
+						LParen:     pos(8, 1),
+						RParen:     pos(9, 1),
+						Semicolon:  pos(10, 1),
+					},
+				},
+				ErrorDefinitions: []*ast.ErrorDefinition{
+					{
+						Error:      pos(1, 2),
+						Identifier: *identPtr("X", pos(7, 2)),
+						LParen:     pos(8, 2),
+						RParen:     pos(9, 2),
+						Semicolon:  pos(10, 2),
+					},
+				},
+			},
+		},
+		{
+			input: "using L for * global;",
+			want: &ast.SourceUnit{
+				UsingDirectives: []*ast.UsingDirective{
+					{
+						Using: pos(1, 1),
+						IdentifierPath: &ast.IdentifierPath{
+							Elements: []*ast.IdentifierPathElement{
+								{Identifier: *identPtr("L", pos(7, 1))},
+							},
+						},
+						For:       pos(9, 1),
+						Mul:       posPtr(13, 1),
+						Global:    posPtr(15, 1),
+						Semicolon: pos(21, 1),
+					},
+				},
+			},
+		},
+		{
+			input: "library L {}",
+			want: &ast.SourceUnit{
+				ContractDefinitions: []*ast.ContractDefinition{
+					{
+						Kind:       ast.ContractKindLibrary,
+						Contract:   pos(1, 1),
+						Identifier: *identPtr("L", pos(9, 1)),
+						LBrace:     pos(11, 1),
+						RBrace:     pos(12, 1),
+					},
+				},
+			},
+		},
+		{
+			input: `interface I {}
+library L {}
+abstract contract C is I {}
+contract D is C {}`,
+			want: &ast.SourceUnit{
+				ContractDefinitions: []*ast.ContractDefinition{
+					{
+						Kind:       ast.ContractKindInterface,
+						Contract:   pos(1, 1),
+						Identifier: *identPtr("I", pos(11, 1)),
+						LBrace:     pos(13, 1),
+						RBrace:     pos(14, 1),
+					},
+					{
+						Kind:       ast.ContractKindLibrary,
+						Contract:   pos(1, 2),
+						Identifier: *identPtr("L", pos(9, 2)),
+						LBrace:     pos(11, 2),
+						RBrace:     pos(12, 2),
+					},
+					{
+						Abstract:   posPtr(1, 3),
+						Kind:       ast.ContractKindContract,
+						Contract:   pos(10, 3),
+						Identifier: *identPtr("C", pos(19, 3)),
+						Is:         posPtr(21, 3),
+						InheritanceSpecifiers: []*ast.InheritanceSpecifier{
+							{
+								IdentifierPath: ast.IdentifierPath{
+									Elements: []*ast.IdentifierPathElement{
+										{Identifier: *identPtr("I", pos(24, 3))},
+									},
+								},
+							},
+						},
+						LBrace: pos(26, 3),
+						RBrace: pos(27, 3),
+					},
+					{
+						Kind:       ast.ContractKindContract,
+						Contract:   pos(1, 4),
+						Identifier: *identPtr("D", pos(10, 4)),
+						Is:         posPtr(12, 4),
+						InheritanceSpecifiers: []*ast.InheritanceSpecifier{
+							{
+								IdentifierPath: ast.IdentifierPath{
+									Elements: []*ast.IdentifierPathElement{
+										{Identifier: *identPtr("C", pos(15, 4))},
+									},
+								},
+							},
+						},
+						LBrace: pos(17, 4),
+						RBrace: pos(18, 4),
+					},
+				},
+			},
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.SourceUnit, error) {
+		return p.Parse()
+	})
+}
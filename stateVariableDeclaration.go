@@ -0,0 +1,99 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func (p *Parser) ParseStateVariableDeclaration() (*ast.StateVariableDeclaration, error) {
+	tn, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	decl := &ast.StateVariableDeclaration{
+		TypeName: tn,
+	}
+
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		switch tkn.Type {
+		case token.Public, token.Private, token.Internal:
+			if decl.Visibility != nil {
+				return nil, token.NewPosError(tkn.Position, "visibility already specified.")
+			}
+			vs, err := p.ParseVisibility()
+			if err != nil {
+				return nil, err
+			}
+			decl.Visibility = &vs
+			continue
+		case token.Constant:
+			if decl.Constant != nil {
+				return nil, token.NewPosError(tkn.Position, "constant already specified.")
+			}
+			if decl.Immutable != nil {
+				return nil, token.NewPosError(tkn.Position, "constant and immutable are not available together.")
+			}
+			p.lexer.Scan()
+			decl.Constant = &tkn.Position
+			continue
+		case token.Immutable:
+			if decl.Immutable != nil {
+				return nil, token.NewPosError(tkn.Position, "immutable already specified.")
+			}
+			if decl.Constant != nil {
+				return nil, token.NewPosError(tkn.Position, "constant and immutable are not available together.")
+			}
+			p.lexer.Scan()
+			decl.Immutable = &tkn.Position
+			continue
+		case token.Override:
+			if decl.OverrideSpecifier != nil {
+				return nil, token.NewPosError(tkn.Position, "override already specified.")
+			}
+			os, err := p.ParseOverrideSpecifier()
+			if err != nil {
+				return nil, err
+			}
+			decl.OverrideSpecifier = os
+			continue
+		}
+		break
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	decl.Identifier = id
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if tkn.Type == token.Assign {
+		p.lexer.Scan()
+		decl.Assign = &tkn.Position
+
+		decl.InitialValue, err = p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+	decl.Semicolon = semi.Position
+
+	return decl, nil
+}
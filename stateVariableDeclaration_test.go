@@ -0,0 +1,108 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseStateVariableDeclaration(t *testing.T) {
+	tests := TestData[*ast.StateVariableDeclaration]{
+		{
+			input: "bool public constant ENABLED = true;",
+			want: &ast.StateVariableDeclaration{
+				TypeName:     ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(1, 1)), Kind: ast.ElementaryTypeNameKindBool},
+				Visibility:   &ast.Visibility{Type: token.Public, Value: "public", Position: pos(6, 1)},
+				Constant:     posPtr(13, 1),
+				Identifier:   *identPtr("ENABLED", pos(22, 1)),
+				Assign:       posPtr(30, 1),
+				InitialValue: &ast.BooleanLiteral{Token: tkn(token.TrueLiteral, "true", pos(32, 1))},
+				Semicolon:    pos(36, 1),
+			},
+		},
+		{
+			input: "address immutable owner;",
+			want: &ast.StateVariableDeclaration{
+				TypeName:   ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(1, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+				Immutable:  posPtr(9, 1),
+				Identifier: *identPtr("owner", pos(19, 1)),
+				Semicolon:  pos(24, 1),
+			},
+		},
+		{
+			input: "string internal override(A) name;",
+			want: &ast.StateVariableDeclaration{
+				TypeName:   ast.ElementaryTypeName{Token: tkn(token.String, "string", pos(1, 1)), Kind: ast.ElementaryTypeNameKindString},
+				Visibility: &ast.Visibility{Type: token.Internal, Value: "internal", Position: pos(8, 1)},
+				OverrideSpecifier: &ast.OverrideSpecifier{
+					Override: pos(17, 1),
+					LParen:   posPtr(25, 1),
+					Paths: []*ast.OverrideSpecifierPath{
+						{
+							IdentifierPath: ast.IdentifierPath{
+								Elements: []*ast.IdentifierPathElement{
+									{Identifier: *identPtr("A", pos(26, 1))},
+								},
+							},
+						},
+					},
+					RParen: posPtr(27, 1),
+				},
+				Identifier: *identPtr("name", pos(29, 1)),
+				Semicolon:  pos(33, 1),
+			},
+		},
+		{
+			input: "mapping(address => bool) internal seen;",
+			want: &ast.StateVariableDeclaration{
+				TypeName: &ast.MappingType{
+					Mapping: pos(1, 1),
+					LParen:  pos(8, 1),
+					KeyType: ast.ElementaryTypeName{
+						Token: tkn(token.Address, "address", pos(9, 1)),
+						Kind:  ast.ElementaryTypeNameKindAddress,
+					},
+					Arrow: pos(17, 1),
+					ValueType: ast.ElementaryTypeName{
+						Token: tkn(token.Bool, "bool", pos(20, 1)),
+						Kind:  ast.ElementaryTypeNameKindBool,
+					},
+					RParen: pos(24, 1),
+				},
+				Visibility: &ast.Visibility{Type: token.Internal, Value: "internal", Position: pos(26, 1)},
+				Identifier: *identPtr("seen", pos(35, 1)),
+				Semicolon:  pos(39, 1),
+			},
+		},
+		{
+			input: "bool constant constant x;",
+			err:   perr(pos(15, 1), "constant already specified."),
+		},
+		{
+			input: "bool public private x;",
+			err:   perr(pos(13, 1), "visibility already specified."),
+		},
+		{
+			input: "address immutable immutable x;",
+			err:   perr(pos(19, 1), "immutable already specified."),
+		},
+		{
+			input: "uint constant immutable x = 1;",
+			err:   perr(pos(15, 1), "constant and immutable are not available together."),
+		},
+		{
+			input: "uint immutable constant x = 1;",
+			err:   perr(pos(16, 1), "constant and immutable are not available together."),
+		},
+		{
+			input: "bool x",
+			err:   perr(pos(7, 1), "not found semicolon."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.StateVariableDeclaration, error) {
+		return p.ParseStateVariableDeclaration()
+	})
+}
@@ -11,11 +11,54 @@ func (p *Parser) ParseStatement() (ast.Statement, error) {
 		return nil, err
 	}
 
-	if tkn.Type == token.Return {
+	switch tkn.Type {
+	case token.LBrace:
+		b, err := p.ParseBlock()
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	case token.Unchecked:
+		ub, err := p.ParseUncheckedBlock()
+		if err != nil {
+			return nil, err
+		}
+		return ub, nil
+	case token.Return:
 		return p.ParseReturnStatement()
+	case token.If:
+		return p.ParseIfStatement()
+	case token.For:
+		return p.ParseForStatement()
+	case token.While:
+		return p.ParseWhileStatement()
+	case token.Do:
+		return p.ParseDoWhileStatement()
+	case token.Continue:
+		return p.ParseContinueStatement()
+	case token.Break:
+		return p.ParseBreakStatement()
+	case token.Emit:
+		return p.ParseEmitStatement()
+	case token.Revert:
+		return p.ParseRevertStatement()
+	case token.Try:
+		return p.ParseTryStatement()
+	case token.Assembly:
+		return p.ParseInlineAssemblyStatement()
 	}
 
-	return nil, token.NewPosError(tkn.Position, "not found statement.")
+	if p.inModifier && tkn.Type == token.Identifier && tkn.Value == "_" {
+		semi, err := p.lexer.PeekN(2)
+		if err != nil {
+			return nil, err
+		}
+		if semi.Type == token.Semicolon {
+			return p.ParsePlaceholderStatement()
+		}
+	}
+
+	return p.parseSimpleStatement()
 }
 
 func (p *Parser) ParseReturnStatement() (ast.Statement, error) {
@@ -28,12 +71,20 @@ func (p *Parser) ParseReturnStatement() (ast.Statement, error) {
 		return nil, token.NewPosError(rtn.Position, "not found return keyword.")
 	}
 
-	exp, err := p.ParseExpression()
+	semi, err := p.lexer.Peek()
 	if err != nil {
 		return nil, err
 	}
 
-	semi, err := p.lexer.Scan()
+	var exp ast.Expression
+	if semi.Type != token.Semicolon {
+		exp, err = p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	semi, err = p.lexer.Scan()
 	if err != nil {
 		return nil, err
 	}
@@ -48,3 +99,595 @@ func (p *Parser) ParseReturnStatement() (ast.Statement, error) {
 		Expression: exp,
 	}, nil
 }
+
+func (p *Parser) ParseExpressionStatement() (*ast.ExpressionStatement, error) {
+	exp, err := p.ParseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.ExpressionStatement{
+		Expression: exp,
+		Semicolon:  semi.Position,
+	}, nil
+}
+
+// parseSimpleStatement parses a variable declaration statement or an expression statement.
+// They are also available as the initialization of for statements.
+func (p *Parser) parseSimpleStatement() (ast.Statement, error) {
+	isTuple, err := p.isVariableDeclarationTuple()
+	if err != nil {
+		return nil, err
+	}
+	if isTuple {
+		vdt, err := p.ParseVariableDeclarationTupleStatement()
+		if err != nil {
+			return nil, err
+		}
+		return vdt, nil
+	}
+
+	isDecl, err := p.isVariableDeclaration(1)
+	if err != nil {
+		return nil, err
+	}
+	if isDecl {
+		vds, err := p.ParseVariableDeclarationStatement()
+		if err != nil {
+			return nil, err
+		}
+		return vds, nil
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if !isExpressionStart(tkn) {
+		return nil, token.NewPosError(tkn.Position, "not found statement.")
+	}
+
+	es, err := p.ParseExpressionStatement()
+	if err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+// parseCondition parses ( Expression ) of if, while and do-while statements.
+func (p *Parser) parseCondition() (lparen token.Pos, exp ast.Expression, rparen token.Pos, err error) {
+	lp, err := p.lexer.Scan()
+	if err != nil {
+		return token.Pos{}, nil, token.Pos{}, err
+	}
+	if lp.Type != token.LParen {
+		return token.Pos{}, nil, token.Pos{}, token.NewPosError(lp.Position, "not found LParen.")
+	}
+
+	exp, err = p.ParseExpression()
+	if err != nil {
+		return token.Pos{}, nil, token.Pos{}, err
+	}
+
+	rp, err := p.lexer.Scan()
+	if err != nil {
+		return token.Pos{}, nil, token.Pos{}, err
+	}
+	if rp.Type != token.RParen {
+		return token.Pos{}, nil, token.Pos{}, token.NewPosError(rp.Position, "not found RParen.")
+	}
+
+	return lp.Position, exp, rp.Position, nil
+}
+
+func (p *Parser) ParseIfStatement() (ast.Statement, error) {
+	i, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if i.Type != token.If {
+		return nil, token.NewPosError(i.Position, "not found if keyword.")
+	}
+
+	lparen, cond, rparen, err := p.parseCondition()
+	if err != nil {
+		return nil, err
+	}
+
+	tBody, err := p.ParseStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	els, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if els.Type != token.Else {
+		return &ast.IfStatement{
+			If:        i.Position,
+			LParen:    lparen,
+			Condition: cond,
+			RParen:    rparen,
+			TrueBody:  tBody,
+		}, nil
+	}
+	p.lexer.Scan()
+
+	fBody, err := p.ParseStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.IfStatement{
+		If:        i.Position,
+		LParen:    lparen,
+		Condition: cond,
+		RParen:    rparen,
+		TrueBody:  tBody,
+		Else:      &els.Position,
+		FalseBody: fBody,
+	}, nil
+}
+
+func (p *Parser) ParseForStatement() (ast.Statement, error) {
+	f, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if f.Type != token.For {
+		return nil, token.NewPosError(f.Position, "not found for keyword.")
+	}
+
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	stmt := &ast.ForStatement{
+		For:    f.Position,
+		LParen: lparen.Position,
+	}
+
+	isemi, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if isemi.Type == token.Semicolon {
+		p.lexer.Scan()
+		stmt.InitSemicolon = &isemi.Position
+	} else {
+		stmt.Init, err = p.parseSimpleStatement()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	csemi, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if csemi.Type == token.Semicolon {
+		p.lexer.Scan()
+		stmt.ConditionSemicolon = &csemi.Position
+	} else {
+		stmt.Condition, err = p.ParseExpressionStatement()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if tkn.Type != token.RParen {
+		stmt.Post, err = p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+	stmt.RParen = rparen.Position
+
+	stmt.Body, err = p.ParseStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) ParseWhileStatement() (ast.Statement, error) {
+	w, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if w.Type != token.While {
+		return nil, token.NewPosError(w.Position, "not found while keyword.")
+	}
+
+	lparen, cond, rparen, err := p.parseCondition()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := p.ParseStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.WhileStatement{
+		While:     w.Position,
+		LParen:    lparen,
+		Condition: cond,
+		RParen:    rparen,
+		Body:      body,
+	}, nil
+}
+
+func (p *Parser) ParseDoWhileStatement() (ast.Statement, error) {
+	d, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if d.Type != token.Do {
+		return nil, token.NewPosError(d.Position, "not found do keyword.")
+	}
+
+	body, err := p.ParseStatement()
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if w.Type != token.While {
+		return nil, token.NewPosError(w.Position, "not found while keyword.")
+	}
+
+	lparen, cond, rparen, err := p.parseCondition()
+	if err != nil {
+		return nil, err
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.DoWhileStatement{
+		Do:        d.Position,
+		Body:      body,
+		While:     w.Position,
+		LParen:    lparen,
+		Condition: cond,
+		RParen:    rparen,
+		Semicolon: semi.Position,
+	}, nil
+}
+
+func (p *Parser) ParseContinueStatement() (ast.Statement, error) {
+	c, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if c.Type != token.Continue {
+		return nil, token.NewPosError(c.Position, "not found continue keyword.")
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.ContinueStatement{
+		Continue:  c.Position,
+		Semicolon: semi.Position,
+	}, nil
+}
+
+func (p *Parser) ParseBreakStatement() (ast.Statement, error) {
+	b, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if b.Type != token.Break {
+		return nil, token.NewPosError(b.Position, "not found break keyword.")
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.BreakStatement{
+		Break:     b.Position,
+		Semicolon: semi.Position,
+	}, nil
+}
+
+func (p *Parser) ParsePlaceholderStatement() (ast.Statement, error) {
+	ph, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if ph.Type != token.Identifier || ph.Value != "_" {
+		return nil, token.NewPosError(ph.Position, "not found placeholder.")
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.PlaceholderStatement{
+		Placeholder: ph.Position,
+		Semicolon:   semi.Position,
+	}, nil
+}
+
+func (p *Parser) ParseEmitStatement() (ast.Statement, error) {
+	emit, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if emit.Type != token.Emit {
+		return nil, token.NewPosError(emit.Position, "not found emit keyword.")
+	}
+
+	ev, err := p.ParseIdentifierPath()
+	if err != nil {
+		return nil, err
+	}
+
+	cal, err := p.ParseCallArgumentList()
+	if err != nil {
+		return nil, err
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.EmitStatement{
+		Emit:             emit.Position,
+		Event:            ev,
+		CallArgumentList: cal,
+		Semicolon:        semi.Position,
+	}, nil
+}
+
+// ParseRevertStatement parses revert Error(...); as a RevertStatement,
+// and revert(...); as an ExpressionStatement calling revert.
+func (p *Parser) ParseRevertStatement() (ast.Statement, error) {
+	rvt, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rvt.Type != token.Revert {
+		return nil, token.NewPosError(rvt.Position, "not found revert keyword.")
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	if tkn.Type == token.LParen {
+		cal, err := p.ParseCallArgumentList()
+		if err != nil {
+			return nil, err
+		}
+
+		semi, err := p.lexer.Scan()
+		if err != nil {
+			return nil, err
+		}
+		if semi.Type != token.Semicolon {
+			return nil, token.NewPosError(semi.Position, "not found semicolon.")
+		}
+
+		id := ast.Identifier(rvt)
+		return &ast.ExpressionStatement{
+			Expression: &ast.FunctionCall{
+				Expression:       &id,
+				CallArgumentList: cal,
+			},
+			Semicolon: semi.Position,
+		}, nil
+	}
+
+	errPath, err := p.ParseIdentifierPath()
+	if err != nil {
+		return nil, err
+	}
+
+	cal, err := p.ParseCallArgumentList()
+	if err != nil {
+		return nil, err
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.RevertStatement{
+		Revert:           rvt.Position,
+		Error:            errPath,
+		CallArgumentList: cal,
+		Semicolon:        semi.Position,
+	}, nil
+}
+
+func (p *Parser) ParseTryStatement() (ast.Statement, error) {
+	try, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if try.Type != token.Try {
+		return nil, token.NewPosError(try.Position, "not found try keyword.")
+	}
+
+	exp, err := p.ParseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := p.ParseFunctionDefinitionReturns()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := p.ParseBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var ccs []*ast.CatchClause
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type != token.Catch {
+			break
+		}
+
+		cc, err := p.ParseCatchClause()
+		if err != nil {
+			return nil, err
+		}
+		ccs = append(ccs, cc)
+	}
+
+	if len(ccs) == 0 {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		return nil, token.NewPosError(tkn.Position, "not found catch clause.")
+	}
+
+	return &ast.TryStatement{
+		Try:          try.Position,
+		Expression:   exp,
+		Returns:      r,
+		Block:        b,
+		CatchClauses: ccs,
+	}, nil
+}
+
+func (p *Parser) ParseCatchClause() (*ast.CatchClause, error) {
+	catch, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if catch.Type != token.Catch {
+		return nil, token.NewPosError(catch.Position, "not found catch keyword.")
+	}
+
+	cc := &ast.CatchClause{
+		Catch: catch.Position,
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if isIdentifier(tkn) {
+		id, err := p.ParseIdentifier()
+		if err != nil {
+			return nil, err
+		}
+		switch id.Value {
+		case "Error":
+			cc.Kind = ast.CatchClauseKindError
+		case "Panic":
+			cc.Kind = ast.CatchClauseKindPanic
+		default:
+			return nil, token.NewPosError(id.Position, "invalid catch clause identifier.")
+		}
+		cc.Identifier = &id
+
+		tkn, err = p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type != token.LParen {
+			return nil, token.NewPosError(tkn.Position, "not found LParen.")
+		}
+	}
+
+	if tkn.Type == token.LParen {
+		p.lexer.Scan()
+
+		pl, err := p.ParseParameterList()
+		if err != nil {
+			return nil, err
+		}
+
+		rparen, err := p.lexer.Scan()
+		if err != nil {
+			return nil, err
+		}
+		if rparen.Type != token.RParen {
+			return nil, token.NewPosError(rparen.Position, "not found RParen.")
+		}
+
+		cc.LParen = &tkn.Position
+		cc.ParameterList = pl
+		cc.RParen = &rparen.Position
+		if cc.Identifier == nil {
+			cc.Kind = ast.CatchClauseKindLowLevel
+		}
+	}
+
+	cc.Block, err = p.ParseBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return cc, nil
+}
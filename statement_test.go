@@ -138,3 +138,383 @@ func TestParser_ParseReturnStatement(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_ParseStatement_Kinds(t *testing.T) {
+	tests := TestData[ast.Statement]{
+		{
+			input: "a = 1;",
+			want: &ast.ExpressionStatement{
+				Expression: &ast.AssignmentExpression{
+					Left:     identPtr("a", pos(1, 1)),
+					Operator: tkn(token.Assign, "=", pos(3, 1)),
+					Right:    &ast.NumberLiteral{Number: tkn(token.Number, "1", pos(5, 1))},
+				},
+				Semicolon: pos(6, 1),
+			},
+		},
+		{
+			input: "return;",
+			want: &ast.ReturnStatement{
+				From:    pos(1, 1),
+				SemiPos: pos(7, 1),
+			},
+		},
+		{
+			input: "if (a) b; else { c; }",
+			want: &ast.IfStatement{
+				If:        pos(1, 1),
+				LParen:    pos(4, 1),
+				Condition: identPtr("a", pos(5, 1)),
+				RParen:    pos(6, 1),
+				TrueBody: &ast.ExpressionStatement{
+					Expression: identPtr("b", pos(8, 1)),
+					Semicolon:  pos(9, 1),
+				},
+				Else: posPtr(11, 1),
+				FalseBody: &ast.Block{
+					LBracePos: pos(16, 1),
+					RBracePos: pos(21, 1),
+					Nodes: []ast.Statement{
+						&ast.ExpressionStatement{
+							Expression: identPtr("c", pos(18, 1)),
+							Semicolon:  pos(19, 1),
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "for (i = 0; i < n; i++) {}",
+			want: &ast.ForStatement{
+				For:    pos(1, 1),
+				LParen: pos(5, 1),
+				Init: &ast.ExpressionStatement{
+					Expression: &ast.AssignmentExpression{
+						Left:     identPtr("i", pos(6, 1)),
+						Operator: tkn(token.Assign, "=", pos(8, 1)),
+						Right:    &ast.NumberLiteral{Number: tkn(token.Number, "0", pos(10, 1))},
+					},
+					Semicolon: pos(11, 1),
+				},
+				Condition: &ast.ExpressionStatement{
+					Expression: &ast.BinaryExpression{
+						Left:     identPtr("i", pos(13, 1)),
+						Operator: tkn(token.LessThan, "<", pos(15, 1)),
+						Right:    identPtr("n", pos(17, 1)),
+					},
+					Semicolon: pos(18, 1),
+				},
+				Post: &ast.UnaryExpression{
+					Operator: tkn(token.Inc, "++", pos(21, 1)),
+					Operand:  identPtr("i", pos(20, 1)),
+					Postfix:  true,
+				},
+				RParen: pos(23, 1),
+				Body: &ast.Block{
+					LBracePos: pos(25, 1),
+					RBracePos: pos(26, 1),
+				},
+			},
+		},
+		{
+			input: "for (;;) break;",
+			want: &ast.ForStatement{
+				For:                pos(1, 1),
+				LParen:             pos(5, 1),
+				InitSemicolon:      posPtr(6, 1),
+				ConditionSemicolon: posPtr(7, 1),
+				RParen:             pos(8, 1),
+				Body: &ast.BreakStatement{
+					Break:     pos(10, 1),
+					Semicolon: pos(15, 1),
+				},
+			},
+		},
+		{
+			input: "while (a) continue;",
+			want: &ast.WhileStatement{
+				While:     pos(1, 1),
+				LParen:    pos(7, 1),
+				Condition: identPtr("a", pos(8, 1)),
+				RParen:    pos(9, 1),
+				Body: &ast.ContinueStatement{
+					Continue:  pos(11, 1),
+					Semicolon: pos(19, 1),
+				},
+			},
+		},
+		{
+			input: "do {} while (a);",
+			want: &ast.DoWhileStatement{
+				Do: pos(1, 1),
+				Body: &ast.Block{
+					LBracePos: pos(4, 1),
+					RBracePos: pos(5, 1),
+				},
+				While:     pos(7, 1),
+				LParen:    pos(13, 1),
+				Condition: identPtr("a", pos(14, 1)),
+				RParen:    pos(15, 1),
+				Semicolon: pos(16, 1),
+			},
+		},
+		{
+			input: "emit Transfer(a);",
+			want: &ast.EmitStatement{
+				Emit: pos(1, 1),
+				Event: ast.IdentifierPath{
+					Elements: []*ast.IdentifierPathElement{
+						{Identifier: ast.Identifier(tkn(token.Identifier, "Transfer", pos(6, 1)))},
+					},
+				},
+				CallArgumentList: &ast.CallArgumentList{
+					LParen: pos(14, 1),
+					Elements: ast.CallArgumentListExpretions{
+						{Expression: identPtr("a", pos(15, 1))},
+					},
+					RParen: pos(16, 1),
+				},
+				Semicolon: pos(17, 1),
+			},
+		},
+		{
+			input: "revert Errors.Unauthorized();",
+			want: &ast.RevertStatement{
+				Revert: pos(1, 1),
+				Error: ast.IdentifierPath{
+					Elements: []*ast.IdentifierPathElement{
+						{
+							Identifier: ast.Identifier(tkn(token.Identifier, "Errors", pos(8, 1))),
+							Period:     posPtr(14, 1),
+						},
+						{Identifier: ast.Identifier(tkn(token.Identifier, "Unauthorized", pos(15, 1)))},
+					},
+				},
+				CallArgumentList: &ast.CallArgumentList{
+					LParen: pos(27, 1),
+					RParen: pos(28, 1),
+				},
+				Semicolon: pos(29, 1),
+			},
+		},
+		{
+			input: `revert("x");`,
+			want: &ast.ExpressionStatement{
+				Expression: &ast.FunctionCall{
+					Expression: &ast.Identifier{
+						Type:     token.Revert,
+						Value:    "revert",
+						Position: pos(1, 1),
+					},
+					CallArgumentList: &ast.CallArgumentList{
+						LParen: pos(7, 1),
+						Elements: ast.CallArgumentListExpretions{
+							{
+								Expression: &ast.StringLiteral{
+									Type:     token.NonEmptyStringLiteral,
+									Value:    `"x"`,
+									Position: pos(8, 1),
+								},
+							},
+						},
+						RParen: pos(11, 1),
+					},
+				},
+				Semicolon: pos(12, 1),
+			},
+		},
+		{
+			input: "_;",
+			want: &ast.ExpressionStatement{
+				Expression: identPtr("_", pos(1, 1)),
+				Semicolon:  pos(2, 1),
+			},
+		},
+		{
+			input: "a + b c",
+			err:   perr(pos(7, 1), "not found semicolon."),
+		},
+		{
+			input: "if a",
+			err:   perr(pos(4, 1), "not found LParen."),
+		},
+		{
+			input: "do {} (a);",
+			err:   perr(pos(7, 1), "not found while keyword."),
+		},
+		{
+			input: "for (a; b) {}",
+			err:   perr(pos(10, 1), "not found semicolon."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.Statement, error) {
+		return p.ParseStatement()
+	})
+}
+
+func TestParser_ParseTryStatement(t *testing.T) {
+	tests := TestData[ast.Statement]{
+		{
+			input: "try f() returns (bool) {} catch Error(string) {} catch (bytes) {} catch {}",
+			want: &ast.TryStatement{
+				Try: pos(1, 1),
+				Expression: &ast.FunctionCall{
+					Expression: identPtr("f", pos(5, 1)),
+					CallArgumentList: &ast.CallArgumentList{
+						LParen: pos(6, 1),
+						RParen: pos(7, 1),
+					},
+				},
+				Returns: &ast.FunctionDefinitionReturns{
+					From:   pos(9, 1),
+					LParen: pos(17, 1),
+					ParameterList: ast.ParameterList{
+						{TypeName: ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(18, 1)), Kind: ast.ElementaryTypeNameKindBool}},
+					},
+					RParen: pos(22, 1),
+				},
+				Block: &ast.Block{
+					LBracePos: pos(24, 1),
+					RBracePos: pos(25, 1),
+				},
+				CatchClauses: []*ast.CatchClause{
+					{
+						Catch: pos(27, 1),
+						Kind:  ast.CatchClauseKindError,
+						Identifier: &ast.Identifier{
+							Type:     token.Identifier,
+							Value:    "Error",
+							Position: pos(33, 1),
+						},
+						LParen: posPtr(38, 1),
+						ParameterList: ast.ParameterList{
+							{TypeName: ast.ElementaryTypeName{Token: tkn(token.String, "string", pos(39, 1)), Kind: ast.ElementaryTypeNameKindString}},
+						},
+						RParen: posPtr(45, 1),
+						Block: &ast.Block{
+							LBracePos: pos(47, 1),
+							RBracePos: pos(48, 1),
+						},
+					},
+					{
+						Catch:  pos(50, 1),
+						Kind:   ast.CatchClauseKindLowLevel,
+						LParen: posPtr(56, 1),
+						ParameterList: ast.ParameterList{
+							{TypeName: ast.ElementaryTypeName{Token: tkn(token.Bytes, "bytes", pos(57, 1)), Kind: ast.ElementaryTypeNameKindBytes}},
+						},
+						RParen: posPtr(62, 1),
+						Block: &ast.Block{
+							LBracePos: pos(64, 1),
+							RBracePos: pos(65, 1),
+						},
+					},
+					{
+						Catch: pos(67, 1),
+						Kind:  ast.CatchClauseKindAll,
+						Block: &ast.Block{
+							LBracePos: pos(73, 1),
+							RBracePos: pos(74, 1),
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "try a.f{value: v}() {} catch {}",
+			want: &ast.TryStatement{
+				Try: pos(1, 1),
+				Expression: &ast.FunctionCall{
+					Expression: &ast.FunctionCallOptions{
+						Expression: &ast.MemberAccess{
+							Expression: identPtr("a", pos(5, 1)),
+							Period:     pos(6, 1),
+							Member:     ast.Identifier(tkn(token.Identifier, "f", pos(7, 1))),
+						},
+						Options: &ast.CallArgumentListNamedExpretions{
+							LBrace: pos(8, 1),
+							NamedExpretions: []*ast.CallArgumentListNamedExpretion{
+								{
+									Identifier: ast.Identifier(tkn(token.Identifier, "value", pos(9, 1))),
+									Colon:      pos(14, 1),
+									Expression: identPtr("v", pos(16, 1)),
+								},
+							},
+							RBrace: pos(17, 1),
+						},
+					},
+					CallArgumentList: &ast.CallArgumentList{
+						LParen: pos(18, 1),
+						RParen: pos(19, 1),
+					},
+				},
+				Block: &ast.Block{
+					LBracePos: pos(21, 1),
+					RBracePos: pos(22, 1),
+				},
+				CatchClauses: []*ast.CatchClause{
+					{
+						Catch: pos(24, 1),
+						Block: &ast.Block{
+							LBracePos: pos(30, 1),
+							RBracePos: pos(31, 1),
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "try f() {} catch Panic(uint code) {}",
+			want: &ast.TryStatement{
+				Try: pos(1, 1),
+				Expression: &ast.FunctionCall{
+					Expression: identPtr("f", pos(5, 1)),
+					CallArgumentList: &ast.CallArgumentList{
+						LParen: pos(6, 1),
+						RParen: pos(7, 1),
+					},
+				},
+				Block: &ast.Block{
+					LBracePos: pos(9, 1),
+					RBracePos: pos(10, 1),
+				},
+				CatchClauses: []*ast.CatchClause{
+					{
+						Catch:      pos(12, 1),
+						Kind:       ast.CatchClauseKindPanic,
+						Identifier: identPtr("Panic", pos(18, 1)),
+						LParen:     posPtr(23, 1),
+						ParameterList: ast.ParameterList{
+							{
+								TypeName:   ast.ElementaryTypeName{Token: tkn(token.Identifier, "uint", pos(24, 1)), Kind: ast.ElementaryTypeNameKindUint, Bits: 256},
+								Identifier: identPtr("code", pos(29, 1)),
+							},
+						},
+						RParen: posPtr(33, 1),
+						Block: &ast.Block{
+							LBracePos: pos(35, 1),
+							RBracePos: pos(36, 1),
+						},
+					},
+				},
+			},
+		},
+		{
+			input: "try f() {}",
+			err:   perr(pos(11, 1), "not found catch clause."),
+		},
+		{
+			input: "try f() {} catch Error {}",
+			err:   perr(pos(24, 1), "not found LParen."),
+		},
+		{
+			input: "try f() {} catch Foo(uint x) {}",
+			err:   perr(pos(18, 1), "invalid catch clause identifier."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.Statement, error) {
+		return p.ParseStatement()
+	})
+}
@@ -0,0 +1,91 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func (p *Parser) ParseStructMember() (*ast.StructMember, error) {
+	tn, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.StructMember{
+		TypeName:   tn,
+		Identifier: id,
+		Semicolon:  semi.Position,
+	}, nil
+}
+
+func (p *Parser) ParseStructDefinition() (*ast.StructDefinition, error) {
+	st, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if st.Type != token.Struct {
+		return nil, token.NewPosError(st.Position, "not found struct keyword.")
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	lbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lbrace.Type != token.LBrace {
+		return nil, token.NewPosError(lbrace.Position, "not found LBrace.")
+	}
+
+	var mbrs []*ast.StructMember
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type == token.RBrace || tkn.Type == token.EOS {
+			if len(mbrs) == 0 {
+				return nil, token.NewPosError(tkn.Position, "not found struct member.")
+			}
+			break
+		}
+
+		mbr, err := p.ParseStructMember()
+		if err != nil {
+			return nil, err
+		}
+		mbrs = append(mbrs, mbr)
+	}
+
+	rbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rbrace.Type != token.RBrace {
+		return nil, token.NewPosError(rbrace.Position, "not found RBrace.")
+	}
+
+	return &ast.StructDefinition{
+		Struct:     st.Position,
+		Identifier: id,
+		LBrace:     lbrace.Position,
+		Members:    mbrs,
+		RBrace:     rbrace.Position,
+	}, nil
+}
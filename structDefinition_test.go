@@ -0,0 +1,51 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseStructDefinition(t *testing.T) {
+	tests := TestData[*ast.StructDefinition]{
+		{
+			input: "struct Point { bool x; address y; }",
+			want: &ast.StructDefinition{
+				Struct:     pos(1, 1),
+				Identifier: *identPtr("Point", pos(8, 1)),
+				LBrace:     pos(14, 1),
+				Members: []*ast.StructMember{
+					{
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(16, 1)), Kind: ast.ElementaryTypeNameKindBool},
+						Identifier: *identPtr("x", pos(21, 1)),
+						Semicolon:  pos(22, 1),
+					},
+					{
+						TypeName:   ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(24, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+						Identifier: *identPtr("y", pos(32, 1)),
+						Semicolon:  pos(33, 1),
+					},
+				},
+				RBrace: pos(35, 1),
+			},
+		},
+		{
+			input: "enum P { A }",
+			err:   perr(pos(1, 1), "not found struct keyword."),
+		},
+		{
+			input: "struct P {}",
+			err:   perr(pos(11, 1), "not found struct member."),
+		},
+		{
+			input: "struct P { bool x }",
+			err:   perr(pos(19, 1), "not found semicolon."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.StructDefinition, error) {
+		return p.ParseStructDefinition()
+	})
+}
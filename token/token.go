@@ -25,6 +25,7 @@ const (
 	Conditional // ?
 	DoubleArrow // =>
 	RightArrow  // ->
+	YulAssign   // :=
 
 	Assign       // =
 	AssignBitOr  // |=
@@ -66,7 +67,7 @@ const (
 	Inc                // ++
 	Dec                // --
 	DoubleQuote        // "
-	SingleQuote        // ' or \'
+	SingleQuote        // '
 
 	// Reserved Keyword
 	After
@@ -157,11 +158,15 @@ const (
 	Struct
 	Try
 	Type
+	Unchecked
 	Using
 	View
 	Virtual
 	While
 
+	// Yul Keyword
+	Leave
+
 	HexString
 
 	// Literal
@@ -207,6 +212,8 @@ func asKeyword(str string) TokenType {
 		return DoubleArrow
 	case "->":
 		return RightArrow
+	case ":=":
+		return YulAssign
 	case "=":
 		return Assign
 	case "|=":
@@ -283,7 +290,7 @@ func asKeyword(str string) TokenType {
 		return Dec
 	case `"`:
 		return DoubleQuote
-	case `\'`:
+	case `'`:
 		return SingleQuote
 	case "after":
 		return After
@@ -427,7 +434,7 @@ func asKeyword(str string) TokenType {
 		return Memory
 	case "modifier":
 		return Modifier
-	case "newKeyword":
+	case "new":
 		return NewKeyword
 	case "override":
 		return Override
@@ -461,6 +468,8 @@ func asKeyword(str string) TokenType {
 		return Try
 	case "type":
 		return Type
+	case "unchecked":
+		return Unchecked
 	case "using":
 		return Using
 	case "view":
@@ -478,6 +487,43 @@ func asToken(str string) TokenType {
 	return asKeyword(str)
 }
 
+// asYulKeyword returns the token type of str in Yul.
+// Most of Solidity keywords are not reserved in Yul and are available as identifiers. (e.g. return, byte, address)
+func asYulKeyword(str string) TokenType {
+	switch str {
+	case "let":
+		return Let
+	case "leave":
+		return Leave
+	case "switch":
+		return Switch
+	case "case":
+		return Case
+	case "default":
+		return Default
+	case "function":
+		return Function
+	case "if":
+		return If
+	case "for":
+		return For
+	case "break":
+		return Break
+	case "continue":
+		return Continue
+	case "true":
+		return TrueLiteral
+	case "false":
+		return FalseLiteral
+	}
+
+	tp := asKeyword(str)
+	if After <= tp && tp <= While {
+		return Identifier
+	}
+	return tp
+}
+
 type Token struct {
 	Type     TokenType
 	Value    string
@@ -492,6 +538,15 @@ func NewToken(ch string, pos Pos) Token {
 	}
 }
 
+// NewYulToken is the same as NewToken but the type is determined by the Yul keywords.
+func NewYulToken(ch string, pos Pos) Token {
+	return Token{
+		Type:     asYulKeyword(ch),
+		Value:    ch,
+		Position: pos,
+	}
+}
+
 type Pos struct {
 	Column int
 	Line   int
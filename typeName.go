@@ -1,22 +1,352 @@
 package solparser
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/uji/solparser/ast"
 	"github.com/uji/solparser/token"
 )
 
+// parseSize parses a decimal size of a sized elementary type name. (e.g. 256 of uint256)
+// ok is false if str is not a decimal number without leading zeros.
+func parseSize(str string) (n int, ok bool) {
+	n, err := strconv.Atoi(str)
+	if err != nil || strconv.Itoa(n) != str {
+		return 0, false
+	}
+	return n, true
+}
+
+// isValidBits reports whether n is a valid size of integer and fixed point types.
+func isValidBits(n int) bool {
+	return 8 <= n && n <= 256 && n%8 == 0
+}
+
+// elementaryTypeName returns the elementary type name of tkn without payable.
+// ok is false if tkn is not an elementary type name.
+// valid is false if tkn is a sized elementary type name with an invalid size. (e.g. uint7, bytes33, fixed8x81)
+func elementaryTypeName(tkn token.Token) (etn ast.ElementaryTypeName, ok bool, valid bool) {
+	etn.Token = tkn
+
+	switch tkn.Type {
+	case token.Address:
+		etn.Kind = ast.ElementaryTypeNameKindAddress
+		return etn, true, true
+	case token.Bool:
+		etn.Kind = ast.ElementaryTypeNameKindBool
+		return etn, true, true
+	case token.String:
+		etn.Kind = ast.ElementaryTypeNameKindString
+		return etn, true, true
+	case token.Bytes:
+		etn.Kind = ast.ElementaryTypeNameKindBytes
+		return etn, true, true
+	case token.Byte:
+		etn.Kind, etn.Bits = ast.ElementaryTypeNameKindFixedBytes, 8
+		return etn, true, true
+	case token.Fixed:
+		etn.Kind, etn.Bits, etn.Decimals = ast.ElementaryTypeNameKindFixed, 128, 18
+		return etn, true, true
+	case token.Identifier:
+	default:
+		return etn, false, false
+	}
+
+	switch v := tkn.Value; {
+	case strings.HasPrefix(v, "uint"), strings.HasPrefix(v, "int"):
+		etn.Kind = ast.ElementaryTypeNameKindInt
+		if v[0] == 'u' {
+			etn.Kind = ast.ElementaryTypeNameKindUint
+		}
+		size := strings.TrimPrefix(strings.TrimPrefix(v, "u"), "int")
+		if size == "" {
+			etn.Bits = 256
+			return etn, true, true
+		}
+		n, ok := parseSize(size)
+		if !ok {
+			return etn, false, false
+		}
+		etn.Bits = n
+		return etn, true, isValidBits(n)
+	case strings.HasPrefix(v, "bytes"):
+		n, ok := parseSize(strings.TrimPrefix(v, "bytes"))
+		if !ok {
+			return etn, false, false
+		}
+		etn.Kind, etn.Bits = ast.ElementaryTypeNameKindFixedBytes, n*8
+		return etn, true, 1 <= n && n <= 32
+	case strings.HasPrefix(v, "ufixed"), strings.HasPrefix(v, "fixed"):
+		etn.Kind = ast.ElementaryTypeNameKindFixed
+		if v[0] == 'u' {
+			etn.Kind = ast.ElementaryTypeNameKindUfixed
+		}
+		size := strings.TrimPrefix(strings.TrimPrefix(v, "u"), "fixed")
+		if size == "" {
+			etn.Bits, etn.Decimals = 128, 18
+			return etn, true, true
+		}
+		m, n, found := strings.Cut(size, "x")
+		if !found {
+			return etn, false, false
+		}
+		bits, ok := parseSize(m)
+		if !ok {
+			return etn, false, false
+		}
+		decimals, ok := parseSize(n)
+		if !ok {
+			return etn, false, false
+		}
+		etn.Bits, etn.Decimals = bits, decimals
+		return etn, true, isValidBits(bits) && decimals <= 80
+	}
+
+	return etn, false, false
+}
+
+// isElementaryTypeName reports whether tkn is an elementary type name.
+// Sized elementary type names with invalid sizes are also reported, to be rejected by ParseElementaryTypeName.
+func isElementaryTypeName(tkn token.Token) bool {
+	_, ok, _ := elementaryTypeName(tkn)
+	return ok
+}
+
+// isTypeNameStart reports whether a type name can start with tkn.
+func isTypeNameStart(tkn token.Token) bool {
+	switch tkn.Type {
+	case token.Mapping, token.Function:
+		return true
+	}
+	return isElementaryTypeName(tkn) || isIdentifier(tkn)
+}
+
 func (p *Parser) ParseTypeName() (ast.TypeName, error) {
 	tkn, err := p.lexer.Peek()
 	if err != nil {
 		return nil, err
 	}
 
-	switch tkn.Type {
-	case token.Address, token.String, token.Bytes, token.Fixed, token.Bool:
-		return p.ParseElementaryTypeName()
+	var tn ast.TypeName
+	switch {
+	case tkn.Type == token.Mapping:
+		tn, err = p.ParseMappingType()
+	case tkn.Type == token.Function:
+		tn, err = p.ParseFunctionTypeName()
+	case isElementaryTypeName(tkn):
+		tn, err = p.ParseElementaryTypeName()
+	case isIdentifier(tkn):
+		tn, err = p.ParseUserDefinedTypeName()
+	default:
+		return nil, token.NewPosError(tkn.Position, "not found type-name.")
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, token.NewPosError(tkn.Position, "not found type-name.")
+	return p.parseArrayTypeName(tn)
+}
+
+// parseArrayTypeName parses brackets following tn. It returns tn if no brackets follow.
+func (p *Parser) parseArrayTypeName(tn ast.TypeName) (ast.TypeName, error) {
+	for {
+		lbrack, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if lbrack.Type != token.LBrack {
+			return tn, nil
+		}
+		p.lexer.Scan()
+
+		rbrack, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		var length ast.Expression
+		if rbrack.Type != token.RBrack {
+			length, err = p.ParseExpression()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rbrack, err = p.lexer.Scan()
+		if err != nil {
+			return nil, err
+		}
+		if rbrack.Type != token.RBrack {
+			return nil, token.NewPosError(rbrack.Position, "not found RBrack.")
+		}
+
+		tn = &ast.ArrayTypeName{
+			TypeName: tn,
+			LBrack:   lbrack.Position,
+			Length:   length,
+			RBrack:   rbrack.Position,
+		}
+	}
+}
+
+// parseMappingElementName parses an optional name of a mapping key or value. (e.g. owner of mapping(address owner => uint))
+func (p *Parser) parseMappingElementName() (*ast.Identifier, error) {
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if !isIdentifier(tkn) {
+		return nil, nil
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func (p *Parser) ParseMappingType() (*ast.MappingType, error) {
+	mp, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if mp.Type != token.Mapping {
+		return nil, token.NewPosError(mp.Position, "not found mapping keyword.")
+	}
+
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	kt, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+	switch kt.(type) {
+	case *ast.MappingType, *ast.ArrayTypeName, *ast.FunctionTypeName:
+		return nil, token.NewPosError(kt.Pos(), "invalid mapping key type.")
+	}
+
+	kn, err := p.parseMappingElementName()
+	if err != nil {
+		return nil, err
+	}
+
+	arrow, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if arrow.Type != token.DoubleArrow {
+		return nil, token.NewPosError(arrow.Position, "not found =>.")
+	}
+
+	vt, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	vn, err := p.parseMappingElementName()
+	if err != nil {
+		return nil, err
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+
+	return &ast.MappingType{
+		Mapping:   mp.Position,
+		LParen:    lparen.Position,
+		KeyType:   kt,
+		KeyName:   kn,
+		Arrow:     arrow.Position,
+		ValueType: vt,
+		ValueName: vn,
+		RParen:    rparen.Position,
+	}, nil
+}
+
+func (p *Parser) ParseFunctionTypeName() (*ast.FunctionTypeName, error) {
+	fn, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if fn.Type != token.Function {
+		return nil, token.NewPosError(fn.Position, "not found function keyword.")
+	}
+
+	lparen, prms, rparen, err := p.parseParameters()
+	if err != nil {
+		return nil, err
+	}
+
+	// identifiers following a function type name are names of variables, not modifier invocations.
+	ml := &ast.ModifierList{}
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		switch tkn.Type {
+		case token.Internal, token.External:
+			if ml.Visibility() != nil {
+				return nil, token.NewPosError(tkn.Position, "visibility already specified.")
+			}
+			vs, err := p.ParseVisibility()
+			if err != nil {
+				return nil, err
+			}
+			ml.Elements = append(ml.Elements, &vs)
+			continue
+		case token.Pure, token.View, token.Payable:
+			if ml.StateMutability() != nil {
+				return nil, token.NewPosError(tkn.Position, "state mutability already specified.")
+			}
+			sm, err := p.ParseStateMutability()
+			if err != nil {
+				return nil, err
+			}
+			ml.Elements = append(ml.Elements, &sm)
+			continue
+		}
+		break
+	}
+
+	r, err := p.ParseFunctionDefinitionReturns()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.FunctionTypeName{
+		Function:     fn.Position,
+		LParen:       lparen,
+		Parameters:   prms,
+		RParen:       rparen,
+		ModifierList: ml,
+		Returns:      r,
+	}, nil
+}
+
+func (p *Parser) ParseUserDefinedTypeName() (*ast.UserDefinedTypeName, error) {
+	ip, err := p.ParseIdentifierPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.UserDefinedTypeName{
+		IdentifierPath: ip,
+	}, nil
 }
 
 func (p *Parser) ParseElementaryTypeName() (ast.TypeName, error) {
@@ -25,22 +355,25 @@ func (p *Parser) ParseElementaryTypeName() (ast.TypeName, error) {
 		return nil, err
 	}
 
+	etn, ok, valid := elementaryTypeName(tkn)
+	if !ok {
+		return nil, token.NewPosError(tkn.Position, "not found elementary type name keyword.")
+	}
+	if !valid {
+		return nil, token.NewPosError(tkn.Position, "invalid elementary type name size.")
+	}
+
 	if tkn.Type == token.Address {
-		tkn2, err := p.lexer.Peek()
+		pyb, err := p.lexer.Peek()
 		if err != nil {
 			return nil, err
 		}
 
-		if tkn2.Type == token.Payable {
+		if pyb.Type == token.Payable {
 			p.lexer.Scan()
-			return ast.ElementaryTypeName{&tkn, &tkn2}, nil
+			etn.Payable = &pyb.Position
 		}
 	}
 
-	switch tkn.Type {
-	case token.Address, token.String, token.Bytes, token.Fixed, token.Bool:
-		return ast.ElementaryTypeName{&tkn}, nil
-	}
-
-	return nil, token.NewPosError(tkn.Position, "not found elementary type name keyword.")
+	return etn, nil
 }
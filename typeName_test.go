@@ -22,11 +22,12 @@ func TestParser_ParseTypeName(t *testing.T) {
 			name:  "ElementaryTypeName",
 			input: "string)",
 			want: ast.ElementaryTypeName{
-				{
+				Token: token.Token{
 					Type:     token.String,
 					Value:    "string",
 					Position: token.Pos{Column: 1, Line: 1},
 				},
+				Kind: ast.ElementaryTypeNameKindString,
 			},
 		},
 		{
@@ -69,46 +70,46 @@ func TestParser_ParseElementaryTypeName(t *testing.T) {
 		{
 			input: "address",
 			want: ast.ElementaryTypeName{
-				{
+				Token: token.Token{
 					Type:     token.Address,
 					Value:    "address",
 					Position: token.Pos{Column: 1, Line: 1},
 				},
+				Kind: ast.ElementaryTypeNameKindAddress,
 			},
 		},
 		{
 			input: "bool",
 			want: ast.ElementaryTypeName{
-				{
+				Token: token.Token{
 					Type:     token.Bool,
 					Value:    "bool",
 					Position: token.Pos{Column: 1, Line: 1},
 				},
+				Kind: ast.ElementaryTypeNameKindBool,
 			},
 		},
 		{
 			input: "string",
 			want: ast.ElementaryTypeName{
-				{
+				Token: token.Token{
 					Type:     token.String,
 					Value:    "string",
 					Position: token.Pos{Column: 1, Line: 1},
 				},
+				Kind: ast.ElementaryTypeNameKindString,
 			},
 		},
 		{
 			input: "address payable",
 			want: ast.ElementaryTypeName{
-				{
+				Token: token.Token{
 					Type:     token.Address,
 					Value:    "address",
 					Position: token.Pos{Column: 1, Line: 1},
 				},
-				{
-					Type:     token.Payable,
-					Value:    "payable",
-					Position: token.Pos{Column: 9, Line: 1},
-				},
+				Payable: &token.Pos{Column: 9, Line: 1},
+				Kind:    ast.ElementaryTypeNameKindAddress,
 			},
 		},
 	}
@@ -133,3 +134,320 @@ func TestParser_ParseElementaryTypeName(t *testing.T) {
 		})
 	}
 }
+
+func TestParser_ParseElementaryTypeName_Sized(t *testing.T) {
+	tests := TestData[ast.TypeName]{
+		{
+			input: "uint256",
+			want: ast.ElementaryTypeName{
+				Token: tkn(token.Identifier, "uint256", pos(1, 1)),
+				Kind:  ast.ElementaryTypeNameKindUint,
+				Bits:  256,
+			},
+		},
+		{
+			input: "uint",
+			want: ast.ElementaryTypeName{
+				Token: tkn(token.Identifier, "uint", pos(1, 1)),
+				Kind:  ast.ElementaryTypeNameKindUint,
+				Bits:  256,
+			},
+		},
+		{
+			input: "int8",
+			want: ast.ElementaryTypeName{
+				Token: tkn(token.Identifier, "int8", pos(1, 1)),
+				Kind:  ast.ElementaryTypeNameKindInt,
+				Bits:  8,
+			},
+		},
+		{
+			input: "int",
+			want: ast.ElementaryTypeName{
+				Token: tkn(token.Identifier, "int", pos(1, 1)),
+				Kind:  ast.ElementaryTypeNameKindInt,
+				Bits:  256,
+			},
+		},
+		{
+			input: "bytes32",
+			want: ast.ElementaryTypeName{
+				Token: tkn(token.Identifier, "bytes32", pos(1, 1)),
+				Kind:  ast.ElementaryTypeNameKindFixedBytes,
+				Bits:  256,
+			},
+		},
+		{
+			input: "byte",
+			want: ast.ElementaryTypeName{
+				Token: tkn(token.Byte, "byte", pos(1, 1)),
+				Kind:  ast.ElementaryTypeNameKindFixedBytes,
+				Bits:  8,
+			},
+		},
+		{
+			input: "bytes",
+			want: ast.ElementaryTypeName{
+				Token: tkn(token.Bytes, "bytes", pos(1, 1)),
+				Kind:  ast.ElementaryTypeNameKindBytes,
+			},
+		},
+		{
+			input: "fixed",
+			want: ast.ElementaryTypeName{
+				Token:    tkn(token.Fixed, "fixed", pos(1, 1)),
+				Kind:     ast.ElementaryTypeNameKindFixed,
+				Bits:     128,
+				Decimals: 18,
+			},
+		},
+		{
+			input: "fixed8x0",
+			want: ast.ElementaryTypeName{
+				Token: tkn(token.Identifier, "fixed8x0", pos(1, 1)),
+				Kind:  ast.ElementaryTypeNameKindFixed,
+				Bits:  8,
+			},
+		},
+		{
+			input: "ufixed128x18",
+			want: ast.ElementaryTypeName{
+				Token:    tkn(token.Identifier, "ufixed128x18", pos(1, 1)),
+				Kind:     ast.ElementaryTypeNameKindUfixed,
+				Bits:     128,
+				Decimals: 18,
+			},
+		},
+		{
+			input: "ufixed",
+			want: ast.ElementaryTypeName{
+				Token:    tkn(token.Identifier, "ufixed", pos(1, 1)),
+				Kind:     ast.ElementaryTypeNameKindUfixed,
+				Bits:     128,
+				Decimals: 18,
+			},
+		},
+		{
+			input: "uint7",
+			err:   perr(pos(1, 1), "invalid elementary type name size."),
+		},
+		{
+			input: "int264",
+			err:   perr(pos(1, 1), "invalid elementary type name size."),
+		},
+		{
+			input: "bytes0",
+			err:   perr(pos(1, 1), "invalid elementary type name size."),
+		},
+		{
+			input: "bytes33",
+			err:   perr(pos(1, 1), "invalid elementary type name size."),
+		},
+		{
+			input: "fixed8x81",
+			err:   perr(pos(1, 1), "invalid elementary type name size."),
+		},
+		{
+			input: "ufixed7x1",
+			err:   perr(pos(1, 1), "invalid elementary type name size."),
+		},
+		{
+			input: "uint08",
+			err:   perr(pos(1, 1), "not found elementary type name keyword."),
+		},
+		{
+			input: "integer",
+			err:   perr(pos(1, 1), "not found elementary type name keyword."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.TypeName, error) {
+		return p.ParseElementaryTypeName()
+	})
+}
+
+func TestParser_ParseTypeName_Composite(t *testing.T) {
+	uintAt := func(pos token.Pos) ast.ElementaryTypeName {
+		return ast.ElementaryTypeName{
+			Token: tkn(token.Identifier, "uint", pos),
+			Kind:  ast.ElementaryTypeNameKindUint,
+			Bits:  256,
+		}
+	}
+	boolAt := func(pos token.Pos) ast.ElementaryTypeName {
+		return ast.ElementaryTypeName{
+			Token: tkn(token.Bool, "bool", pos),
+			Kind:  ast.ElementaryTypeNameKindBool,
+		}
+	}
+
+	tests := TestData[ast.TypeName]{
+		{
+			input: "mapping(address owner => mapping(uint => bool) approved)",
+			want: &ast.MappingType{
+				Mapping: pos(1, 1),
+				LParen:  pos(8, 1),
+				KeyType: ast.ElementaryTypeName{
+					Token: tkn(token.Address, "address", pos(9, 1)),
+					Kind:  ast.ElementaryTypeNameKindAddress,
+				},
+				KeyName: identPtr("owner", pos(17, 1)),
+				Arrow:   pos(23, 1),
+				ValueType: &ast.MappingType{
+					Mapping:   pos(26, 1),
+					LParen:    pos(33, 1),
+					KeyType:   uintAt(pos(34, 1)),
+					Arrow:     pos(39, 1),
+					ValueType: boolAt(pos(42, 1)),
+					RParen:    pos(46, 1),
+				},
+				ValueName: identPtr("approved", pos(48, 1)),
+				RParen:    pos(56, 1),
+			},
+		},
+		{
+			input: "uint[]",
+			want: &ast.ArrayTypeName{
+				TypeName: uintAt(pos(1, 1)),
+				LBrack:   pos(5, 1),
+				RBrack:   pos(6, 1),
+			},
+		},
+		{
+			input: "bytes32[4][]",
+			want: &ast.ArrayTypeName{
+				TypeName: &ast.ArrayTypeName{
+					TypeName: ast.ElementaryTypeName{
+						Token: tkn(token.Identifier, "bytes32", pos(1, 1)),
+						Kind:  ast.ElementaryTypeNameKindFixedBytes,
+						Bits:  256,
+					},
+					LBrack: pos(8, 1),
+					Length: &ast.NumberLiteral{Number: tkn(token.Number, "4", pos(9, 1))},
+					RBrack: pos(10, 1),
+				},
+				LBrack: pos(11, 1),
+				RBrack: pos(12, 1),
+			},
+		},
+		{
+			input: "bool[N + 1]",
+			want: &ast.ArrayTypeName{
+				TypeName: boolAt(pos(1, 1)),
+				LBrack:   pos(5, 1),
+				Length: &ast.BinaryExpression{
+					Left:     identPtr("N", pos(6, 1)),
+					Operator: tkn(token.Add, "+", pos(8, 1)),
+					Right:    &ast.NumberLiteral{Number: tkn(token.Number, "1", pos(10, 1))},
+				},
+				RBrack: pos(11, 1),
+			},
+		},
+		{
+			input: "function (uint) external view returns (bool)",
+			want: &ast.FunctionTypeName{
+				Function: pos(1, 1),
+				LParen:   pos(10, 1),
+				Parameters: ast.ParameterList{
+					{TypeName: uintAt(pos(11, 1))},
+				},
+				RParen: pos(15, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{Type: token.External, Value: "external", Position: pos(17, 1)},
+						&ast.StateMutability{Type: token.View, Value: "view", Position: pos(26, 1)},
+					},
+				},
+				Returns: &ast.FunctionDefinitionReturns{
+					From:   pos(31, 1),
+					LParen: pos(39, 1),
+					ParameterList: ast.ParameterList{
+						{TypeName: boolAt(pos(40, 1))},
+					},
+					RParen: pos(44, 1),
+				},
+			},
+		},
+		{
+			input: "function () internal f",
+			want: &ast.FunctionTypeName{
+				Function: pos(1, 1),
+				LParen:   pos(10, 1),
+				RParen:   pos(11, 1),
+				ModifierList: &ast.ModifierList{
+					Elements: []ast.ModifierListElement{
+						&ast.Visibility{Type: token.Internal, Value: "internal", Position: pos(13, 1)},
+					},
+				},
+			},
+		},
+		{
+			input: "mapping(uint[] => bool)",
+			err:   perr(pos(9, 1), "invalid mapping key type."),
+		},
+		{
+			input: "mapping(uint bool)",
+			err:   perr(pos(14, 1), "not found =>."),
+		},
+		{
+			input: "uint[1",
+			err:   perr(pos(7, 1), "not found RBrack."),
+		},
+		{
+			input: "function () external external",
+			err:   perr(pos(22, 1), "visibility already specified."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.TypeName, error) {
+		return p.ParseTypeName()
+	})
+}
+
+func TestParser_ParseTypeName_UserDefined(t *testing.T) {
+	tests := TestData[ast.TypeName]{
+		{
+			input: "IERC20",
+			want: &ast.UserDefinedTypeName{
+				IdentifierPath: ast.IdentifierPath{
+					Elements: []*ast.IdentifierPathElement{
+						{Identifier: *identPtr("IERC20", pos(1, 1))},
+					},
+				},
+			},
+		},
+		{
+			input: "Lib.Struct",
+			want: &ast.UserDefinedTypeName{
+				IdentifierPath: ast.IdentifierPath{
+					Elements: []*ast.IdentifierPathElement{
+						{Identifier: *identPtr("Lib", pos(1, 1)), Period: posPtr(4, 1)},
+						{Identifier: *identPtr("Struct", pos(5, 1))},
+					},
+				},
+			},
+		},
+		{
+			input: "MyEnum[]",
+			want: &ast.ArrayTypeName{
+				TypeName: &ast.UserDefinedTypeName{
+					IdentifierPath: ast.IdentifierPath{
+						Elements: []*ast.IdentifierPathElement{
+							{Identifier: *identPtr("MyEnum", pos(1, 1))},
+						},
+					},
+				},
+				LBrack: pos(7, 1),
+				RBrack: pos(8, 1),
+			},
+		},
+		{
+			input: "Lib.",
+			err:   perr(pos(5, 1), "keyword is not available as identifier."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.TypeName, error) {
+		return p.ParseTypeName()
+	})
+}
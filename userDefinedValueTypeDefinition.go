@@ -0,0 +1,50 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func (p *Parser) ParseUserDefinedValueTypeDefinition() (*ast.UserDefinedValueTypeDefinition, error) {
+	tp, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if tp.Type != token.Type {
+		return nil, token.NewPosError(tp.Position, "not found type keyword.")
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	is, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if is.Type != token.Is {
+		return nil, token.NewPosError(is.Position, "not found is keyword.")
+	}
+
+	tn, err := p.ParseElementaryTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.UserDefinedValueTypeDefinition{
+		Type:       tp.Position,
+		Identifier: id,
+		Is:         is.Position,
+		TypeName:   tn,
+		Semicolon:  semi.Position,
+	}, nil
+}
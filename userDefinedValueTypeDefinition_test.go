@@ -0,0 +1,36 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseUserDefinedValueTypeDefinition(t *testing.T) {
+	tests := TestData[*ast.UserDefinedValueTypeDefinition]{
+		{
+			input: "type Owner is address;",
+			want: &ast.UserDefinedValueTypeDefinition{
+				Type:       pos(1, 1),
+				Identifier: *identPtr("Owner", pos(6, 1)),
+				Is:         pos(12, 1),
+				TypeName:   ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(15, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+				Semicolon:  pos(22, 1),
+			},
+		},
+		{
+			input: "type Owner = address;",
+			err:   perr(pos(12, 1), "not found is keyword."),
+		},
+		{
+			input: "type Owner is address",
+			err:   perr(pos(22, 1), "not found semicolon."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.UserDefinedValueTypeDefinition, error) {
+		return p.ParseUserDefinedValueTypeDefinition()
+	})
+}
@@ -0,0 +1,172 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func isUserDefinableOperator(tp token.TokenType) bool {
+	switch tp {
+	case token.BitAnd, token.BitOr, token.BitXor, token.BitNot,
+		token.Add, token.Sub, token.Mul, token.Div, token.Mod,
+		token.Equal, token.NotEqual, token.LessThan, token.LessThanOrEqual, token.GreaterThan, token.GreaterThanOrEqual:
+		return true
+	}
+	return false
+}
+
+func (p *Parser) ParseUsingAlias() (*ast.UsingAlias, error) {
+	ip, err := p.ParseIdentifierPath()
+	if err != nil {
+		return nil, err
+	}
+
+	as, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if as.Type != token.As {
+		return &ast.UsingAlias{IdentifierPath: ip}, nil
+	}
+	p.lexer.Scan()
+
+	op, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if !isUserDefinableOperator(op.Type) {
+		return nil, token.NewPosError(op.Position, "not found user-definable operator.")
+	}
+
+	return &ast.UsingAlias{
+		IdentifierPath: ip,
+		As:             &as.Position,
+		Operator:       &op,
+	}, nil
+}
+
+func (p *Parser) ParseUsingAliases() (*ast.UsingAliases, error) {
+	lbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lbrace.Type != token.LBrace {
+		return nil, token.NewPosError(lbrace.Position, "not found LBrace.")
+	}
+
+	alias, err := p.ParseUsingAlias()
+	if err != nil {
+		return nil, err
+	}
+
+	aliases := []*ast.UsingAlias{alias}
+	commas := make([]*token.Pos, 0)
+
+	for {
+		comma, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if comma.Type != token.Comma {
+			break
+		}
+		p.lexer.Scan()
+
+		alias, err := p.ParseUsingAlias()
+		if err != nil {
+			return nil, err
+		}
+
+		aliases = append(aliases, alias)
+		commas = append(commas, &comma.Position)
+	}
+
+	rbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rbrace.Type != token.RBrace {
+		return nil, token.NewPosError(rbrace.Position, "not found RBrace.")
+	}
+
+	return &ast.UsingAliases{
+		LBrace:  lbrace.Position,
+		Aliases: aliases,
+		Commas:  commas,
+		RBrace:  rbrace.Position,
+	}, nil
+}
+
+func (p *Parser) ParseUsingDirective() (*ast.UsingDirective, error) {
+	using, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if using.Type != token.Using {
+		return nil, token.NewPosError(using.Position, "not found using keyword.")
+	}
+
+	ud := &ast.UsingDirective{
+		Using: using.Position,
+	}
+
+	lbrace, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if lbrace.Type == token.LBrace {
+		ud.UsingAliases, err = p.ParseUsingAliases()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		ip, err := p.ParseIdentifierPath()
+		if err != nil {
+			return nil, err
+		}
+		ud.IdentifierPath = &ip
+	}
+
+	fr, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if fr.Type != token.For {
+		return nil, token.NewPosError(fr.Position, "not found for keyword.")
+	}
+	ud.For = fr.Position
+
+	mul, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if mul.Type == token.Mul {
+		p.lexer.Scan()
+		ud.Mul = &mul.Position
+	} else {
+		ud.TypeName, err = p.ParseTypeName()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	glbl, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if glbl.Type == token.Global {
+		p.lexer.Scan()
+		ud.Global = &glbl.Position
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+	ud.Semicolon = semi.Position
+
+	return ud, nil
+}
@@ -0,0 +1,92 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseUsingDirective(t *testing.T) {
+	tests := TestData[*ast.UsingDirective]{
+		{
+			input: "using SafeMath for bool;",
+			want: &ast.UsingDirective{
+				Using: pos(1, 1),
+				IdentifierPath: &ast.IdentifierPath{
+					Elements: []*ast.IdentifierPathElement{
+						{Identifier: *identPtr("SafeMath", pos(7, 1))},
+					},
+				},
+				For:       pos(16, 1),
+				TypeName:  ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(20, 1)), Kind: ast.ElementaryTypeNameKindBool},
+				Semicolon: pos(24, 1),
+			},
+		},
+		{
+			input: "using L for *;",
+			want: &ast.UsingDirective{
+				Using: pos(1, 1),
+				IdentifierPath: &ast.IdentifierPath{
+					Elements: []*ast.IdentifierPathElement{
+						{Identifier: *identPtr("L", pos(7, 1))},
+					},
+				},
+				For:       pos(9, 1),
+				Mul:       posPtr(13, 1),
+				Semicolon: pos(14, 1),
+			},
+		},
+		{
+			input: "using {add as +, lib.sub} for address global;",
+			want: &ast.UsingDirective{
+				Using: pos(1, 1),
+				UsingAliases: &ast.UsingAliases{
+					LBrace: pos(7, 1),
+					Aliases: []*ast.UsingAlias{
+						{
+							IdentifierPath: ast.IdentifierPath{
+								Elements: []*ast.IdentifierPathElement{
+									{Identifier: *identPtr("add", pos(8, 1))},
+								},
+							},
+							As:       posPtr(12, 1),
+							Operator: tknPtr(token.Add, "+", pos(15, 1)),
+						},
+						{
+							IdentifierPath: ast.IdentifierPath{
+								Elements: []*ast.IdentifierPathElement{
+									{Identifier: *identPtr("lib", pos(18, 1)), Period: posPtr(21, 1)},
+									{Identifier: *identPtr("sub", pos(22, 1))},
+								},
+							},
+						},
+					},
+					Commas: []*token.Pos{posPtr(16, 1)},
+					RBrace: pos(25, 1),
+				},
+				For:       pos(27, 1),
+				TypeName:  ast.ElementaryTypeName{Token: tkn(token.Address, "address", pos(31, 1)), Kind: ast.ElementaryTypeNameKindAddress},
+				Global:    posPtr(39, 1),
+				Semicolon: pos(45, 1),
+			},
+		},
+		{
+			input: "using L;",
+			err:   perr(pos(8, 1), "not found for keyword."),
+		},
+		{
+			input: "using {add as !} for bool;",
+			err:   perr(pos(15, 1), "not found user-definable operator."),
+		},
+		{
+			input: "using L for bool",
+			err:   perr(pos(17, 1), "not found semicolon."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*ast.UsingDirective, error) {
+		return p.ParseUsingDirective()
+	})
+}
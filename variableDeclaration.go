@@ -0,0 +1,293 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func isDataLocation(tp token.TokenType) bool {
+	switch tp {
+	case token.Memory, token.Storage, token.Calldata:
+		return true
+	}
+	return false
+}
+
+// skipTypeName returns the lookahead offset next to the type name which starts at the n-th token.
+// It returns 0 if no type name starts at the n-th token.
+// Array brackets are skipped without parsing their lengths.
+func (p *Parser) skipTypeName(n int) (int, error) {
+	tkn, err := p.lexer.PeekN(n)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case tkn.Type == token.Address:
+		n++
+		pyb, err := p.lexer.PeekN(n)
+		if err != nil {
+			return 0, err
+		}
+		if pyb.Type == token.Payable {
+			n++
+		}
+	case isElementaryTypeName(tkn):
+		n++
+	case isIdentifier(tkn):
+		n++
+		for {
+			prd, err := p.lexer.PeekN(n)
+			if err != nil {
+				return 0, err
+			}
+			id, err := p.lexer.PeekN(n + 1)
+			if err != nil {
+				return 0, err
+			}
+			if prd.Type != token.Period || !isIdentifier(id) {
+				break
+			}
+			n += 2
+		}
+	default:
+		return 0, nil
+	}
+
+	for {
+		lbrack, err := p.lexer.PeekN(n)
+		if err != nil {
+			return 0, err
+		}
+		if lbrack.Type != token.LBrack {
+			return n, nil
+		}
+		n++
+
+		for depth := 1; depth > 0; n++ {
+			tkn, err := p.lexer.PeekN(n)
+			if err != nil {
+				return 0, err
+			}
+			switch tkn.Type {
+			case token.LBrack:
+				depth++
+			case token.RBrack:
+				depth--
+			case token.EOS:
+				return 0, nil
+			}
+		}
+	}
+}
+
+// isVariableDeclaration reports whether a variable declaration starts at the n-th token.
+// It is a declaration if a type name is followed by a data location or an identifier. (e.g. uint x, bytes memory b)
+func (p *Parser) isVariableDeclaration(n int) (bool, error) {
+	tkn, err := p.lexer.PeekN(n)
+	if err != nil {
+		return false, err
+	}
+	// mapping and function types are not available as expressions.
+	if tkn.Type == token.Mapping || tkn.Type == token.Function {
+		return true, nil
+	}
+
+	n, err = p.skipTypeName(n)
+	if err != nil || n == 0 {
+		return false, err
+	}
+
+	tkn, err = p.lexer.PeekN(n)
+	if err != nil {
+		return false, err
+	}
+	return isDataLocation(tkn.Type) || isIdentifier(tkn), nil
+}
+
+// isVariableDeclarationTuple reports whether the next tokens start a tuple of variable declarations.
+// (e.g. (uint a, , bool c) = f();)
+func (p *Parser) isVariableDeclarationTuple() (bool, error) {
+	lparen, err := p.lexer.Peek()
+	if err != nil || lparen.Type != token.LParen {
+		return false, err
+	}
+
+	n := 2
+	for {
+		cmm, err := p.lexer.PeekN(n)
+		if err != nil {
+			return false, err
+		}
+		if cmm.Type != token.Comma {
+			break
+		}
+		n++
+	}
+
+	return p.isVariableDeclaration(n)
+}
+
+func (p *Parser) ParseDataLocation() (token.Token, error) {
+	tkn, err := p.lexer.Scan()
+	if err != nil {
+		return token.Token{}, err
+	}
+
+	if !isDataLocation(tkn.Type) {
+		return token.Token{}, token.NewPosError(tkn.Position, "not found data location.")
+	}
+
+	return tkn, nil
+}
+
+func (p *Parser) ParseVariableDeclaration() (*ast.VariableDeclaration, error) {
+	tn, err := p.ParseTypeName()
+	if err != nil {
+		return nil, err
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var dl *token.Token
+	if isDataLocation(tkn.Type) {
+		d, err := p.ParseDataLocation()
+		if err != nil {
+			return nil, err
+		}
+		dl = &d
+	}
+
+	id, err := p.ParseIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.VariableDeclaration{
+		TypeName:     tn,
+		DataLocation: dl,
+		Identifier:   id,
+	}, nil
+}
+
+func (p *Parser) ParseVariableDeclarationStatement() (*ast.VariableDeclarationStatement, error) {
+	vd, err := p.ParseVariableDeclaration()
+	if err != nil {
+		return nil, err
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	var assign *token.Pos
+	var exp ast.Expression
+	if tkn.Type == token.Assign {
+		p.lexer.Scan()
+		assign = &tkn.Position
+
+		exp, err = p.ParseExpression()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.VariableDeclarationStatement{
+		VariableDeclaration: vd,
+		Assign:              assign,
+		InitialValue:        exp,
+		Semicolon:           semi.Position,
+	}, nil
+}
+
+func (p *Parser) ParseVariableDeclarationTupleStatement() (*ast.VariableDeclarationTupleStatement, error) {
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	var cmps []*ast.VariableDeclarationTupleComponent
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		var vd *ast.VariableDeclaration
+		if tkn.Type != token.Comma && tkn.Type != token.RParen {
+			vd, err = p.ParseVariableDeclaration()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		cmm, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if cmm.Type != token.Comma {
+			cmps = append(cmps, &ast.VariableDeclarationTupleComponent{
+				VariableDeclaration: vd,
+			})
+			break
+		}
+		p.lexer.Scan()
+		cmps = append(cmps, &ast.VariableDeclarationTupleComponent{
+			VariableDeclaration: vd,
+			Comma:               &cmm.Position,
+		})
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+
+	assign, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if assign.Type != token.Assign {
+		return nil, token.NewPosError(assign.Position, "not found assign.")
+	}
+
+	exp, err := p.ParseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	semi, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if semi.Type != token.Semicolon {
+		return nil, token.NewPosError(semi.Position, "not found semicolon.")
+	}
+
+	return &ast.VariableDeclarationTupleStatement{
+		LParen:       lparen.Position,
+		Components:   cmps,
+		RParen:       rparen.Position,
+		Assign:       assign.Position,
+		InitialValue: exp,
+		Semicolon:    semi.Position,
+	}, nil
+}
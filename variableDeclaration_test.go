@@ -0,0 +1,208 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/token"
+)
+
+func TestParser_ParseStatement_VariableDeclaration(t *testing.T) {
+	tests := TestData[ast.Statement]{
+		{
+			input: "bool x = true;",
+			want: &ast.VariableDeclarationStatement{
+				VariableDeclaration: &ast.VariableDeclaration{
+					TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(1, 1)), Kind: ast.ElementaryTypeNameKindBool},
+					Identifier: ast.Identifier(tkn(token.Identifier, "x", pos(6, 1))),
+				},
+				Assign: posPtr(8, 1),
+				InitialValue: &ast.BooleanLiteral{
+					Token: tkn(token.TrueLiteral, "true", pos(10, 1)),
+				},
+				Semicolon: pos(14, 1),
+			},
+		},
+		{
+			input: "bytes memory b;",
+			want: &ast.VariableDeclarationStatement{
+				VariableDeclaration: &ast.VariableDeclaration{
+					TypeName:     ast.ElementaryTypeName{Token: tkn(token.Bytes, "bytes", pos(1, 1)), Kind: ast.ElementaryTypeNameKindBytes},
+					DataLocation: tknPtr(token.Memory, "memory", pos(7, 1)),
+					Identifier:   ast.Identifier(tkn(token.Identifier, "b", pos(14, 1))),
+				},
+				Semicolon: pos(15, 1),
+			},
+		},
+		{
+			input: "uint256 a = 1;",
+			want: &ast.VariableDeclarationStatement{
+				VariableDeclaration: &ast.VariableDeclaration{
+					TypeName: ast.ElementaryTypeName{
+						Token: tkn(token.Identifier, "uint256", pos(1, 1)),
+						Kind:  ast.ElementaryTypeNameKindUint,
+						Bits:  256,
+					},
+					Identifier: ast.Identifier(tkn(token.Identifier, "a", pos(9, 1))),
+				},
+				Assign:       posPtr(11, 1),
+				InitialValue: &ast.NumberLiteral{Number: tkn(token.Number, "1", pos(13, 1))},
+				Semicolon:    pos(14, 1),
+			},
+		},
+		{
+			input: "Lib.S memory s;",
+			want: &ast.VariableDeclarationStatement{
+				VariableDeclaration: &ast.VariableDeclaration{
+					TypeName: &ast.UserDefinedTypeName{
+						IdentifierPath: ast.IdentifierPath{
+							Elements: []*ast.IdentifierPathElement{
+								{Identifier: *identPtr("Lib", pos(1, 1)), Period: posPtr(4, 1)},
+								{Identifier: *identPtr("S", pos(5, 1))},
+							},
+						},
+					},
+					DataLocation: tknPtr(token.Memory, "memory", pos(7, 1)),
+					Identifier:   ast.Identifier(tkn(token.Identifier, "s", pos(14, 1))),
+				},
+				Semicolon: pos(15, 1),
+			},
+		},
+		{
+			input: "IERC20 token;",
+			want: &ast.VariableDeclarationStatement{
+				VariableDeclaration: &ast.VariableDeclaration{
+					TypeName: &ast.UserDefinedTypeName{
+						IdentifierPath: ast.IdentifierPath{
+							Elements: []*ast.IdentifierPathElement{
+								{Identifier: *identPtr("IERC20", pos(1, 1))},
+							},
+						},
+					},
+					Identifier: ast.Identifier(tkn(token.Identifier, "token", pos(8, 1))),
+				},
+				Semicolon: pos(13, 1),
+			},
+		},
+		{
+			input: "uint[] memory a;",
+			want: &ast.VariableDeclarationStatement{
+				VariableDeclaration: &ast.VariableDeclaration{
+					TypeName: &ast.ArrayTypeName{
+						TypeName: ast.ElementaryTypeName{
+							Token: tkn(token.Identifier, "uint", pos(1, 1)),
+							Kind:  ast.ElementaryTypeNameKindUint,
+							Bits:  256,
+						},
+						LBrack: pos(5, 1),
+						RBrack: pos(6, 1),
+					},
+					DataLocation: tknPtr(token.Memory, "memory", pos(8, 1)),
+					Identifier:   ast.Identifier(tkn(token.Identifier, "a", pos(15, 1))),
+				},
+				Semicolon: pos(16, 1),
+			},
+		},
+		{
+			input: "address payable a;",
+			want: &ast.VariableDeclarationStatement{
+				VariableDeclaration: &ast.VariableDeclaration{
+					TypeName: ast.ElementaryTypeName{
+						Token:   tkn(token.Address, "address", pos(1, 1)),
+						Payable: posPtr(9, 1),
+						Kind:    ast.ElementaryTypeNameKindAddress,
+					},
+					Identifier: ast.Identifier(tkn(token.Identifier, "a", pos(17, 1))),
+				},
+				Semicolon: pos(18, 1),
+			},
+		},
+		{
+			input: "(bool a, , string calldata c) = f();",
+			want: &ast.VariableDeclarationTupleStatement{
+				LParen: pos(1, 1),
+				Components: []*ast.VariableDeclarationTupleComponent{
+					{
+						VariableDeclaration: &ast.VariableDeclaration{
+							TypeName:   ast.ElementaryTypeName{Token: tkn(token.Bool, "bool", pos(2, 1)), Kind: ast.ElementaryTypeNameKindBool},
+							Identifier: ast.Identifier(tkn(token.Identifier, "a", pos(7, 1))),
+						},
+						Comma: posPtr(8, 1),
+					},
+					{Comma: posPtr(10, 1)},
+					{
+						VariableDeclaration: &ast.VariableDeclaration{
+							TypeName:     ast.ElementaryTypeName{Token: tkn(token.String, "string", pos(12, 1)), Kind: ast.ElementaryTypeNameKindString},
+							DataLocation: tknPtr(token.Calldata, "calldata", pos(19, 1)),
+							Identifier:   ast.Identifier(tkn(token.Identifier, "c", pos(28, 1))),
+						},
+					},
+				},
+				RParen: pos(29, 1),
+				Assign: pos(31, 1),
+				InitialValue: &ast.FunctionCall{
+					Expression: identPtr("f", pos(33, 1)),
+					CallArgumentList: &ast.CallArgumentList{
+						LParen: pos(34, 1),
+						RParen: pos(35, 1),
+					},
+				},
+				Semicolon: pos(36, 1),
+			},
+		},
+		{
+			input: "(x, y) = (y, x);",
+			want: &ast.ExpressionStatement{
+				Expression: &ast.AssignmentExpression{
+					Left: &ast.TupleExpression{
+						LParen: pos(1, 1),
+						Components: []*ast.TupleComponent{
+							{Expression: identPtr("x", pos(2, 1)), Comma: posPtr(3, 1)},
+							{Expression: identPtr("y", pos(5, 1))},
+						},
+						RParen: pos(6, 1),
+					},
+					Operator: tkn(token.Assign, "=", pos(8, 1)),
+					Right: &ast.TupleExpression{
+						LParen: pos(10, 1),
+						Components: []*ast.TupleComponent{
+							{Expression: identPtr("y", pos(11, 1)), Comma: posPtr(12, 1)},
+							{Expression: identPtr("x", pos(14, 1))},
+						},
+						RParen: pos(15, 1),
+					},
+				},
+				Semicolon: pos(16, 1),
+			},
+		},
+		{
+			input: "a[i] = b;",
+			want: &ast.ExpressionStatement{
+				Expression: &ast.AssignmentExpression{
+					Left: &ast.IndexAccess{
+						Expression: identPtr("a", pos(1, 1)),
+						LBrack:     pos(2, 1),
+						Index:      identPtr("i", pos(3, 1)),
+						RBrack:     pos(4, 1),
+					},
+					Operator: tkn(token.Assign, "=", pos(6, 1)),
+					Right:    identPtr("b", pos(8, 1)),
+				},
+				Semicolon: pos(9, 1),
+			},
+		},
+		{
+			input: "string memory;",
+			err:   perr(pos(14, 1), "keyword is not available as identifier."),
+		},
+		{
+			input: "(bool a) f();",
+			err:   perr(pos(10, 1), "not found assign."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.Statement, error) {
+		return p.ParseStatement()
+	})
+}
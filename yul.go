@@ -0,0 +1,767 @@
+package solparser
+
+import (
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/ast/yul"
+	"github.com/uji/solparser/lexer"
+	"github.com/uji/solparser/token"
+)
+
+func (p *Parser) ParseInlineAssemblyStatement() (ast.Statement, error) {
+	asm, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if asm.Type != token.Assembly {
+		return nil, token.NewPosError(asm.Position, "not found assembly keyword.")
+	}
+
+	stmt := &ast.InlineAssemblyStatement{
+		Assembly: asm.Position,
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if tkn.Type == token.NonEmptyStringLiteral {
+		p.lexer.Scan()
+		dialect := ast.StringLiteral(tkn)
+		stmt.Dialect = &dialect
+	}
+
+	lparen, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type == token.LParen {
+		p.lexer.Scan()
+		stmt.LParen = &lparen.Position
+
+		for {
+			flg, err := p.lexer.Scan()
+			if err != nil {
+				return nil, err
+			}
+			if flg.Type != token.NonEmptyStringLiteral {
+				return nil, token.NewPosError(flg.Position, "not found assembly flag.")
+			}
+			flag := ast.StringLiteral(flg)
+
+			cmm, err := p.lexer.Peek()
+			if err != nil {
+				return nil, err
+			}
+			if cmm.Type != token.Comma {
+				stmt.Flags = append(stmt.Flags, &ast.InlineAssemblyFlag{
+					Flag: &flag,
+				})
+				break
+			}
+			p.lexer.Scan()
+			stmt.Flags = append(stmt.Flags, &ast.InlineAssemblyFlag{
+				Flag:  &flag,
+				Comma: &cmm.Position,
+			})
+		}
+
+		rparen, err := p.lexer.Scan()
+		if err != nil {
+			return nil, err
+		}
+		if rparen.Type != token.RParen {
+			return nil, token.NewPosError(rparen.Position, "not found RParen.")
+		}
+		stmt.RParen = &rparen.Position
+	}
+
+	// The lexer tokenizes Yul between the braces.
+	lbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lbrace.Type != token.LBrace {
+		return nil, token.NewPosError(lbrace.Position, "not found LBrace.")
+	}
+
+	if err := p.lexer.SetMode(lexer.YulMode); err != nil {
+		return nil, err
+	}
+
+	stmt.Block, err = p.parseYulBlockBody(lbrace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.lexer.SetMode(lexer.SolidityMode); err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+// ParseYulBlock parses a Yul block. The lexer must be in Yul mode.
+func (p *Parser) ParseYulBlock() (*yul.Block, error) {
+	lbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lbrace.Type != token.LBrace {
+		return nil, token.NewPosError(lbrace.Position, "not found LBrace.")
+	}
+
+	return p.parseYulBlockBody(lbrace)
+}
+
+// parseYulBlockBody parses the statements and RBrace of the block which begins with lbrace.
+// No token is peeked after RBrace, so that the lexer mode is available to switch.
+func (p *Parser) parseYulBlockBody(lbrace token.Token) (*yul.Block, error) {
+	var stmts []yul.Statement
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type == token.RBrace || tkn.Type == token.EOS {
+			break
+		}
+
+		stmt, err := p.ParseYulStatement()
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	rbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rbrace.Type != token.RBrace {
+		return nil, token.NewPosError(rbrace.Position, "not found RBrace.")
+	}
+
+	return &yul.Block{
+		LBrace:     lbrace.Position,
+		Statements: stmts,
+		RBrace:     rbrace.Position,
+	}, nil
+}
+
+func (p *Parser) ParseYulStatement() (yul.Statement, error) {
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tkn.Type {
+	case token.LBrace:
+		b, err := p.ParseYulBlock()
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	case token.Let:
+		return p.ParseYulVariableDeclaration()
+	case token.If:
+		return p.ParseYulIf()
+	case token.Switch:
+		return p.ParseYulSwitch()
+	case token.For:
+		return p.ParseYulFor()
+	case token.Function:
+		return p.ParseYulFunctionDefinition()
+	case token.Leave:
+		p.lexer.Scan()
+		return &yul.Leave{Leave: tkn.Position}, nil
+	case token.Break:
+		p.lexer.Scan()
+		return &yul.Break{Break: tkn.Position}, nil
+	case token.Continue:
+		p.lexer.Scan()
+		return &yul.Continue{Continue: tkn.Position}, nil
+	case token.Identifier:
+		lparen, err := p.lexer.PeekN(2)
+		if err != nil {
+			return nil, err
+		}
+		if lparen.Type == token.LParen {
+			fc, err := p.parseYulFunctionCall()
+			if err != nil {
+				return nil, err
+			}
+			return fc, nil
+		}
+		return p.parseYulAssignment()
+	}
+
+	return nil, token.NewPosError(tkn.Position, "not found yul statement.")
+}
+
+func isYulLiteral(tp token.TokenType) bool {
+	switch tp {
+	case token.Number, token.NonEmptyStringLiteral, token.EmptyStringLiteral, token.HexString,
+		token.TrueLiteral, token.FalseLiteral:
+		return true
+	}
+	return false
+}
+
+func (p *Parser) ParseYulExpression() (yul.Expression, error) {
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+
+	if isYulLiteral(tkn.Type) {
+		p.lexer.Scan()
+		lit := yul.Literal(tkn)
+		return &lit, nil
+	}
+
+	if tkn.Type != token.Identifier {
+		return nil, token.NewPosError(tkn.Position, "not found yul expression.")
+	}
+
+	lparen, err := p.lexer.PeekN(2)
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type == token.LParen {
+		fc, err := p.parseYulFunctionCall()
+		if err != nil {
+			return nil, err
+		}
+		return fc, nil
+	}
+
+	p.lexer.Scan()
+	id := yul.Identifier(tkn)
+	return &id, nil
+}
+
+func (p *Parser) parseYulIdentifier() (yul.Identifier, error) {
+	tkn, err := p.lexer.Scan()
+	if err != nil {
+		return yul.Identifier{}, err
+	}
+	if tkn.Type != token.Identifier {
+		return yul.Identifier{}, token.NewPosError(tkn.Position, "not found identifier.")
+	}
+	return yul.Identifier(tkn), nil
+}
+
+func (p *Parser) parseYulFunctionCall() (*yul.FunctionCall, error) {
+	id, err := p.parseYulIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	var args []*yul.Argument
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if tkn.Type == token.RParen {
+			break
+		}
+
+		exp, err := p.ParseYulExpression()
+		if err != nil {
+			return nil, err
+		}
+
+		cmm, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if cmm.Type != token.Comma {
+			args = append(args, &yul.Argument{
+				Expression: exp,
+			})
+			break
+		}
+		p.lexer.Scan()
+		args = append(args, &yul.Argument{
+			Expression: exp,
+			Comma:      &cmm.Position,
+		})
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+
+	return &yul.FunctionCall{
+		Identifier: id,
+		LParen:     lparen.Position,
+		Arguments:  args,
+		RParen:     rparen.Position,
+	}, nil
+}
+
+// parseYulIdentifierList parses Identifier ( , Identifier )*.
+func (p *Parser) parseYulIdentifierList() ([]*yul.IdentifierListElement, error) {
+	var ids []*yul.IdentifierListElement
+	for {
+		id, err := p.parseYulIdentifier()
+		if err != nil {
+			return nil, err
+		}
+
+		cmm, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if cmm.Type != token.Comma {
+			ids = append(ids, &yul.IdentifierListElement{
+				Identifier: id,
+			})
+			return ids, nil
+		}
+		p.lexer.Scan()
+		ids = append(ids, &yul.IdentifierListElement{
+			Identifier: id,
+			Comma:      &cmm.Position,
+		})
+	}
+}
+
+func (p *Parser) ParseYulVariableDeclaration() (yul.Statement, error) {
+	let, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if let.Type != token.Let {
+		return nil, token.NewPosError(let.Position, "not found let keyword.")
+	}
+
+	ids, err := p.parseYulIdentifierList()
+	if err != nil {
+		return nil, err
+	}
+
+	assign, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if assign.Type != token.YulAssign {
+		return &yul.VariableDeclaration{
+			Let:         let.Position,
+			Identifiers: ids,
+		}, nil
+	}
+	p.lexer.Scan()
+
+	exp, err := p.ParseYulExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	return &yul.VariableDeclaration{
+		Let:         let.Position,
+		Identifiers: ids,
+		Assign:      &assign.Position,
+		Value:       exp,
+	}, nil
+}
+
+func (p *Parser) parseYulAssignment() (yul.Statement, error) {
+	ids, err := p.parseYulIdentifierList()
+	if err != nil {
+		return nil, err
+	}
+
+	assign, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if assign.Type != token.YulAssign {
+		return nil, token.NewPosError(assign.Position, "not found :=.")
+	}
+
+	exp, err := p.ParseYulExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	return &yul.Assignment{
+		Identifiers: ids,
+		Assign:      assign.Position,
+		Value:       exp,
+	}, nil
+}
+
+func (p *Parser) ParseYulIf() (yul.Statement, error) {
+	i, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if i.Type != token.If {
+		return nil, token.NewPosError(i.Position, "not found if keyword.")
+	}
+
+	cond, err := p.ParseYulExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := p.ParseYulBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &yul.If{
+		If:        i.Position,
+		Condition: cond,
+		Body:      b,
+	}, nil
+}
+
+func (p *Parser) ParseYulSwitch() (yul.Statement, error) {
+	sw, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if sw.Type != token.Switch {
+		return nil, token.NewPosError(sw.Position, "not found switch keyword.")
+	}
+
+	exp, err := p.ParseYulExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := &yul.Switch{
+		Switch:     sw.Position,
+		Expression: exp,
+	}
+
+	for {
+		cs, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+		if cs.Type != token.Case {
+			break
+		}
+		p.lexer.Scan()
+
+		lit, err := p.lexer.Scan()
+		if err != nil {
+			return nil, err
+		}
+		if !isYulLiteral(lit.Type) {
+			return nil, token.NewPosError(lit.Position, "not found literal.")
+		}
+		value := yul.Literal(lit)
+
+		b, err := p.ParseYulBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		stmt.Cases = append(stmt.Cases, &yul.Case{
+			Case:  cs.Position,
+			Value: &value,
+			Body:  b,
+		})
+	}
+
+	dflt, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if dflt.Type == token.Default {
+		p.lexer.Scan()
+
+		b, err := p.ParseYulBlock()
+		if err != nil {
+			return nil, err
+		}
+
+		stmt.Default = &yul.Default{
+			Default: dflt.Position,
+			Body:    b,
+		}
+	}
+
+	if len(stmt.Cases) == 0 && stmt.Default == nil {
+		return nil, token.NewPosError(dflt.Position, "not found case or default.")
+	}
+
+	return stmt, nil
+}
+
+func (p *Parser) ParseYulFor() (yul.Statement, error) {
+	f, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if f.Type != token.For {
+		return nil, token.NewPosError(f.Position, "not found for keyword.")
+	}
+
+	init, err := p.ParseYulBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	cond, err := p.ParseYulExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := p.ParseYulBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := p.ParseYulBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &yul.For{
+		For:       f.Position,
+		Init:      init,
+		Condition: cond,
+		Post:      post,
+		Body:      body,
+	}, nil
+}
+
+func (p *Parser) ParseYulFunctionDefinition() (yul.Statement, error) {
+	fnc, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if fnc.Type != token.Function {
+		return nil, token.NewPosError(fnc.Position, "not found function keyword.")
+	}
+
+	name, err := p.parseYulIdentifier()
+	if err != nil {
+		return nil, err
+	}
+
+	lparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lparen.Type != token.LParen {
+		return nil, token.NewPosError(lparen.Position, "not found LParen.")
+	}
+
+	stmt := &yul.FunctionDefinition{
+		Function: fnc.Position,
+		Name:     name,
+		LParen:   lparen.Position,
+	}
+
+	tkn, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if tkn.Type != token.RParen {
+		stmt.Parameters, err = p.parseYulIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rparen, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rparen.Type != token.RParen {
+		return nil, token.NewPosError(rparen.Position, "not found RParen.")
+	}
+	stmt.RParen = rparen.Position
+
+	arrow, err := p.lexer.Peek()
+	if err != nil {
+		return nil, err
+	}
+	if arrow.Type == token.RightArrow {
+		p.lexer.Scan()
+		stmt.Arrow = &arrow.Position
+
+		stmt.Returns, err = p.parseYulIdentifierList()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stmt.Body, err = p.ParseYulBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return stmt, nil
+}
+
+// ParseYul parses a standalone Yul object such as the compiler IR output.
+// The lexer is switched to Yul mode, so that Parse is not available after ParseYul.
+func (p *Parser) ParseYul() (*yul.Object, error) {
+	if err := p.lexer.SetMode(lexer.YulMode); err != nil {
+		return nil, err
+	}
+
+	obj, err := p.ParseYulObject()
+	if err != nil {
+		return nil, err
+	}
+
+	eos, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if eos.Type != token.EOS {
+		return nil, token.NewPosError(eos.Position, "invalid")
+	}
+
+	return obj, nil
+}
+
+// object, code and data are not keywords but identifiers in Yul.
+func isYulObjectKeyword(tkn token.Token, keyword string) bool {
+	return tkn.Type == token.Identifier && tkn.Value == keyword
+}
+
+// ParseYulObject parses object Name { Code ( Object | Data )* }. The lexer must be in Yul mode.
+func (p *Parser) ParseYulObject() (*yul.Object, error) {
+	obj, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if !isYulObjectKeyword(obj, "object") {
+		return nil, token.NewPosError(obj.Position, "not found object.")
+	}
+
+	name, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if name.Type != token.NonEmptyStringLiteral {
+		return nil, token.NewPosError(name.Position, "not found object name.")
+	}
+	nm := yul.Literal(name)
+
+	lbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if lbrace.Type != token.LBrace {
+		return nil, token.NewPosError(lbrace.Position, "not found LBrace.")
+	}
+
+	code, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if !isYulObjectKeyword(code, "code") {
+		return nil, token.NewPosError(code.Position, "not found code.")
+	}
+
+	b, err := p.ParseYulBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var elms []yul.ObjectElement
+	for {
+		tkn, err := p.lexer.Peek()
+		if err != nil {
+			return nil, err
+		}
+
+		if isYulObjectKeyword(tkn, "object") {
+			o, err := p.ParseYulObject()
+			if err != nil {
+				return nil, err
+			}
+			elms = append(elms, o)
+			continue
+		}
+		if isYulObjectKeyword(tkn, "data") {
+			d, err := p.ParseYulData()
+			if err != nil {
+				return nil, err
+			}
+			elms = append(elms, d)
+			continue
+		}
+		break
+	}
+
+	rbrace, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if rbrace.Type != token.RBrace {
+		return nil, token.NewPosError(rbrace.Position, "not found RBrace.")
+	}
+
+	return &yul.Object{
+		Object: obj.Position,
+		Name:   &nm,
+		LBrace: lbrace.Position,
+		Code: &yul.Code{
+			Code:  code.Position,
+			Block: b,
+		},
+		Elements: elms,
+		RBrace:   rbrace.Position,
+	}, nil
+}
+
+// ParseYulData parses data Name Value. The lexer must be in Yul mode.
+func (p *Parser) ParseYulData() (*yul.Data, error) {
+	data, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if !isYulObjectKeyword(data, "data") {
+		return nil, token.NewPosError(data.Position, "not found data.")
+	}
+
+	name, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	if name.Type != token.NonEmptyStringLiteral {
+		return nil, token.NewPosError(name.Position, "not found data name.")
+	}
+	nm := yul.Literal(name)
+
+	v, err := p.lexer.Scan()
+	if err != nil {
+		return nil, err
+	}
+	switch v.Type {
+	case token.NonEmptyStringLiteral, token.EmptyStringLiteral, token.HexString:
+	default:
+		return nil, token.NewPosError(v.Position, "not found data value.")
+	}
+	value := yul.Literal(v)
+
+	return &yul.Data{
+		Data:  data.Position,
+		Name:  &nm,
+		Value: &value,
+	}, nil
+}
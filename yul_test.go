@@ -0,0 +1,336 @@
+package solparser_test
+
+import (
+	"testing"
+
+	"github.com/uji/solparser"
+	"github.com/uji/solparser/ast"
+	"github.com/uji/solparser/ast/yul"
+	"github.com/uji/solparser/token"
+)
+
+func yulIdent(text string, pos token.Pos) yul.Identifier {
+	return yul.Identifier(tkn(token.Identifier, text, pos))
+}
+
+func yulIdentPtr(text string, pos token.Pos) *yul.Identifier {
+	id := yulIdent(text, pos)
+	return &id
+}
+
+func yulNumber(text string, pos token.Pos) *yul.Literal {
+	return &yul.Literal{Type: token.Number, Value: text, Position: pos}
+}
+
+func TestParser_ParseInlineAssemblyStatement(t *testing.T) {
+	tests := TestData[ast.Statement]{
+		{
+			input: `assembly ("memory-safe") { let x := add(1, y.slot) }`,
+			want: &ast.InlineAssemblyStatement{
+				Assembly: pos(1, 1),
+				LParen:   posPtr(10, 1),
+				Flags: []*ast.InlineAssemblyFlag{
+					{
+						Flag: &ast.StringLiteral{
+							Type:     token.NonEmptyStringLiteral,
+							Value:    `"memory-safe"`,
+							Position: pos(11, 1),
+						},
+					},
+				},
+				RParen: posPtr(24, 1),
+				Block: &yul.Block{
+					LBrace: pos(26, 1),
+					Statements: []yul.Statement{
+						&yul.VariableDeclaration{
+							Let: pos(28, 1),
+							Identifiers: []*yul.IdentifierListElement{
+								{Identifier: yulIdent("x", pos(32, 1))},
+							},
+							Assign: posPtr(34, 1),
+							Value: &yul.FunctionCall{
+								Identifier: yulIdent("add", pos(37, 1)),
+								LParen:     pos(40, 1),
+								Arguments: []*yul.Argument{
+									{Expression: yulNumber("1", pos(41, 1)), Comma: posPtr(42, 1)},
+									{Expression: yulIdentPtr("y.slot", pos(44, 1))},
+								},
+								RParen: pos(50, 1),
+							},
+						},
+					},
+					RBrace: pos(52, 1),
+				},
+			},
+		},
+		{
+			input: `assembly "evmasm" { switch x case 0 { leave } default { x := 1 } }`,
+			want: &ast.InlineAssemblyStatement{
+				Assembly: pos(1, 1),
+				Dialect: &ast.StringLiteral{
+					Type:     token.NonEmptyStringLiteral,
+					Value:    `"evmasm"`,
+					Position: pos(10, 1),
+				},
+				Block: &yul.Block{
+					LBrace: pos(19, 1),
+					Statements: []yul.Statement{
+						&yul.Switch{
+							Switch:     pos(21, 1),
+							Expression: yulIdentPtr("x", pos(28, 1)),
+							Cases: []*yul.Case{
+								{
+									Case:  pos(30, 1),
+									Value: yulNumber("0", pos(35, 1)),
+									Body: &yul.Block{
+										LBrace:     pos(37, 1),
+										Statements: []yul.Statement{&yul.Leave{Leave: pos(39, 1)}},
+										RBrace:     pos(45, 1),
+									},
+								},
+							},
+							Default: &yul.Default{
+								Default: pos(47, 1),
+								Body: &yul.Block{
+									LBrace: pos(55, 1),
+									Statements: []yul.Statement{
+										&yul.Assignment{
+											Identifiers: []*yul.IdentifierListElement{
+												{Identifier: yulIdent("x", pos(57, 1))},
+											},
+											Assign: pos(59, 1),
+											Value:  yulNumber("1", pos(62, 1)),
+										},
+									},
+									RBrace: pos(64, 1),
+								},
+							},
+						},
+					},
+					RBrace: pos(66, 1),
+				},
+			},
+		},
+		{
+			input: `assembly { for { let i := 0 } lt(i, n) { i := add(i, 1) } { break } }`,
+			want: &ast.InlineAssemblyStatement{
+				Assembly: pos(1, 1),
+				Block: &yul.Block{
+					LBrace: pos(10, 1),
+					Statements: []yul.Statement{
+						&yul.For{
+							For: pos(12, 1),
+							Init: &yul.Block{
+								LBrace: pos(16, 1),
+								Statements: []yul.Statement{
+									&yul.VariableDeclaration{
+										Let: pos(18, 1),
+										Identifiers: []*yul.IdentifierListElement{
+											{Identifier: yulIdent("i", pos(22, 1))},
+										},
+										Assign: posPtr(24, 1),
+										Value:  yulNumber("0", pos(27, 1)),
+									},
+								},
+								RBrace: pos(29, 1),
+							},
+							Condition: &yul.FunctionCall{
+								Identifier: yulIdent("lt", pos(31, 1)),
+								LParen:     pos(33, 1),
+								Arguments: []*yul.Argument{
+									{Expression: yulIdentPtr("i", pos(34, 1)), Comma: posPtr(35, 1)},
+									{Expression: yulIdentPtr("n", pos(37, 1))},
+								},
+								RParen: pos(38, 1),
+							},
+							Post: &yul.Block{
+								LBrace: pos(40, 1),
+								Statements: []yul.Statement{
+									&yul.Assignment{
+										Identifiers: []*yul.IdentifierListElement{
+											{Identifier: yulIdent("i", pos(42, 1))},
+										},
+										Assign: pos(44, 1),
+										Value: &yul.FunctionCall{
+											Identifier: yulIdent("add", pos(47, 1)),
+											LParen:     pos(50, 1),
+											Arguments: []*yul.Argument{
+												{Expression: yulIdentPtr("i", pos(51, 1)), Comma: posPtr(52, 1)},
+												{Expression: yulNumber("1", pos(54, 1))},
+											},
+											RParen: pos(55, 1),
+										},
+									},
+								},
+								RBrace: pos(57, 1),
+							},
+							Body: &yul.Block{
+								LBrace:     pos(59, 1),
+								Statements: []yul.Statement{&yul.Break{Break: pos(61, 1)}},
+								RBrace:     pos(67, 1),
+							},
+						},
+					},
+					RBrace: pos(69, 1),
+				},
+			},
+		},
+		{
+			input: `assembly { function f(a, b) -> r { r := a } }`,
+			want: &ast.InlineAssemblyStatement{
+				Assembly: pos(1, 1),
+				Block: &yul.Block{
+					LBrace: pos(10, 1),
+					Statements: []yul.Statement{
+						&yul.FunctionDefinition{
+							Function: pos(12, 1),
+							Name:     yulIdent("f", pos(21, 1)),
+							LParen:   pos(22, 1),
+							Parameters: []*yul.IdentifierListElement{
+								{Identifier: yulIdent("a", pos(23, 1)), Comma: posPtr(24, 1)},
+								{Identifier: yulIdent("b", pos(26, 1))},
+							},
+							RParen: pos(27, 1),
+							Arrow:  posPtr(29, 1),
+							Returns: []*yul.IdentifierListElement{
+								{Identifier: yulIdent("r", pos(32, 1))},
+							},
+							Body: &yul.Block{
+								LBrace: pos(34, 1),
+								Statements: []yul.Statement{
+									&yul.Assignment{
+										Identifiers: []*yul.IdentifierListElement{
+											{Identifier: yulIdent("r", pos(36, 1))},
+										},
+										Assign: pos(38, 1),
+										Value:  yulIdentPtr("a", pos(41, 1)),
+									},
+								},
+								RBrace: pos(43, 1),
+							},
+						},
+					},
+					RBrace: pos(45, 1),
+				},
+			},
+		},
+		{
+			input: `{ assembly {} a.b; }`,
+			want: &ast.Block{
+				LBracePos: pos(1, 1),
+				RBracePos: pos(20, 1),
+				Nodes: []ast.Statement{
+					&ast.InlineAssemblyStatement{
+						Assembly: pos(3, 1),
+						Block: &yul.Block{
+							LBrace: pos(12, 1),
+							RBrace: pos(13, 1),
+						},
+					},
+					&ast.ExpressionStatement{
+						Expression: &ast.MemberAccess{
+							Expression: identPtr("a", pos(15, 1)),
+							Period:     pos(16, 1),
+							Member:     ast.Identifier(tkn(token.Identifier, "b", pos(17, 1))),
+						},
+						Semicolon: pos(18, 1),
+					},
+				},
+			},
+		},
+		{
+			input: `assembly { x }`,
+			err:   perr(pos(14, 1), "not found :=."),
+		},
+		{
+			input: `assembly { switch x }`,
+			err:   perr(pos(21, 1), "not found case or default."),
+		},
+		{
+			input: `assembly ("memory-safe" {}`,
+			err:   perr(pos(25, 1), "not found RParen."),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (ast.Statement, error) {
+		return p.ParseStatement()
+	})
+}
+
+func TestParser_ParseYul(t *testing.T) {
+	tests := TestData[*yul.Object]{
+		{
+			input: `object "A" {
+  code { sstore(0, 1) }
+  object "A_deployed" {
+    code { }
+    data "Table" hex"4123"
+  }
+  data "s" "abc"
+}`,
+			want: &yul.Object{
+				Object: pos(1, 1),
+				Name:   &yul.Literal{Type: token.NonEmptyStringLiteral, Value: `"A"`, Position: pos(8, 1)},
+				LBrace: pos(12, 1),
+				Code: &yul.Code{
+					Code: pos(3, 2),
+					Block: &yul.Block{
+						LBrace: pos(8, 2),
+						Statements: []yul.Statement{
+							&yul.FunctionCall{
+								Identifier: yulIdent("sstore", pos(10, 2)),
+								LParen:     pos(16, 2),
+								Arguments: []*yul.Argument{
+									{Expression: yulNumber("0", pos(17, 2)), Comma: posPtr(18, 2)},
+									{Expression: yulNumber("1", pos(20, 2))},
+								},
+								RParen: pos(21, 2),
+							},
+						},
+						RBrace: pos(23, 2),
+					},
+				},
+				Elements: []yul.ObjectElement{
+					&yul.Object{
+						Object: pos(3, 3),
+						Name:   &yul.Literal{Type: token.NonEmptyStringLiteral, Value: `"A_deployed"`, Position: pos(10, 3)},
+						LBrace: pos(23, 3),
+						Code: &yul.Code{
+							Code: pos(5, 4),
+							Block: &yul.Block{
+								LBrace: pos(10, 4),
+								RBrace: pos(12, 4),
+							},
+						},
+						Elements: []yul.ObjectElement{
+							&yul.Data{
+								Data:  pos(5, 5),
+								Name:  &yul.Literal{Type: token.NonEmptyStringLiteral, Value: `"Table"`, Position: pos(10, 5)},
+								Value: &yul.Literal{Type: token.HexString, Value: `hex"4123"`, Position: pos(18, 5)},
+							},
+						},
+						RBrace: pos(3, 6),
+					},
+					&yul.Data{
+						Data:  pos(3, 7),
+						Name:  &yul.Literal{Type: token.NonEmptyStringLiteral, Value: `"s"`, Position: pos(8, 7)},
+						Value: &yul.Literal{Type: token.NonEmptyStringLiteral, Value: `"abc"`, Position: pos(12, 7)},
+					},
+				},
+				RBrace: pos(1, 8),
+			},
+		},
+		{
+			input: `object "A" { }`,
+			err:   perr(pos(14, 1), "not found code."),
+		},
+		{
+			input: `object "A" { code {} } x`,
+			err:   perr(pos(24, 1), "invalid"),
+		},
+	}
+
+	tests.Test(t, func(p *solparser.Parser) (*yul.Object, error) {
+		return p.ParseYul()
+	})
+}